@@ -0,0 +1,161 @@
+// Package autolink rewrites HTML content so the mentions of known releasers
+// link to their releaser pages.
+//
+// Only the text nodes are rewritten, the tags, attributes and comments of the HTML are kept as is.
+package autolink
+
+import (
+	"bytes"
+	"errors"
+	"html"
+	"io"
+	"strings"
+
+	"github.com/Defacto2/releaser"
+	"github.com/Defacto2/releaser/initialism"
+	"github.com/Defacto2/releaser/mention"
+	"github.com/Defacto2/releaser/name"
+	xhtml "golang.org/x/net/html"
+)
+
+// Prefix is the URL path prefix of the releaser pages.
+const Prefix = name.RedirectPrefix
+
+// Options are the settings used when rewriting HTML content.
+type Options struct {
+	First    bool // First links only the first occurrence of each releaser.
+	SkipCode bool // SkipCode leaves the text within <code>, <pre>, <kbd> and <samp> elements unlinked.
+	Abbr     bool // Abbr wraps acronyms and initialisms in an <abbr> element that uses the full name as its title.
+}
+
+// rawText returns true if the element contents are never rewritten.
+// The text within existing <a> elements is never linked, as HTML does not allow nested links.
+func rawText(tag string) bool {
+	switch tag {
+	case "a", "script", "style", "textarea", "title", "noscript", "iframe", "svg", "math":
+		return true
+	}
+	return false
+}
+
+// skip returns true if the element contents are not rewritten using the options.
+func (opts Options) skip(tag string) bool {
+	switch tag {
+	case "code", "pre", "kbd", "samp":
+		return opts.SkipCode
+	}
+	return rawText(tag)
+}
+
+// Link returns a copy of the HTML content with the mentions of known releasers
+// wrapped in links to their /g/{path} releaser pages.
+//
+// Example:
+//
+//	Link(`<p>Greets to TDT</p>`, Options{Abbr: true}) =
+//		`<p>Greets to <a href="/g/the-dream-team"><abbr title="The Dream Team" aria-label="T D T">TDT</abbr></a></p>`
+func Link(src string, opts Options) (string, error) {
+	var b bytes.Buffer
+	if err := Rewrite(&b, strings.NewReader(src), opts); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// Rewrite reads the HTML content from r and writes a copy to w with the mentions
+// of known releasers wrapped in links to their /g/{path} releaser pages.
+//
+// The link paths are the releaser URL paths that the mentions resolved to, see [mention.Mention].
+// Mentions of initialisms that are shared by multiple releasers, such as RZR, are not linked.
+func Rewrite(w io.Writer, r io.Reader, opts Options) error {
+	z := xhtml.NewTokenizer(r)
+	linked := map[string]bool{}
+	depth := 0
+	for {
+		tt := z.Next()
+		// the raw token must be copied before z.Text, which unescapes the buffer in place
+		raw := bytes.Clone(z.Raw())
+		switch tt {
+		case xhtml.ErrorToken:
+			if err := z.Err(); !errors.Is(err, io.EOF) {
+				return err
+			}
+			return nil
+		case xhtml.StartTagToken:
+			if tag, _ := z.TagName(); opts.skip(string(tag)) {
+				depth++
+			}
+		case xhtml.EndTagToken:
+			if tag, _ := z.TagName(); opts.skip(string(tag)) && depth > 0 {
+				depth--
+			}
+		case xhtml.TextToken:
+			if depth > 0 {
+				break
+			}
+			if linkedText, ok := text(string(z.Text()), opts, linked); ok {
+				if _, err := io.WriteString(w, linkedText); err != nil {
+					return err
+				}
+				continue
+			}
+		case xhtml.SelfClosingTagToken, xhtml.CommentToken, xhtml.DoctypeToken:
+		}
+		if _, err := w.Write(raw); err != nil {
+			return err
+		}
+	}
+}
+
+// escape replaces the special characters of the text with their HTML entities.
+var escape = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;") //nolint:gochecknoglobals
+
+// text returns the escaped text with the mentions of known releasers wrapped in links,
+// or false if there is nothing to link.
+// The linked map records the URL paths that have already been linked.
+func text(s string, opts Options, linked map[string]bool) (string, bool) {
+	var b strings.Builder
+	last := 0
+	for _, m := range mention.Find(s) {
+		path := href(m)
+		if path == "" || (opts.First && linked[path]) {
+			continue
+		}
+		linked[path] = true
+		b.WriteString(escape.Replace(s[last:m.Start]))
+		b.WriteString(`<a href="` + html.EscapeString(Prefix+path) + `">`)
+		if abbr, found := spelling(path, m); opts.Abbr && found {
+			b.WriteString(abbr.Abbr(releaser.Humanize(path)))
+		} else {
+			b.WriteString(escape.Replace(m.Text))
+		}
+		b.WriteString(`</a>`)
+		last = m.End
+	}
+	if last == 0 {
+		return "", false
+	}
+	b.WriteString(escape.Replace(s[last:]))
+	return b.String(), true
+}
+
+// href returns the URL path that the mention resolved to,
+// or an empty string if the mention is shared by multiple releasers.
+func href(m mention.Mention) string {
+	if len(m.Paths) != 1 {
+		return ""
+	}
+	return string(m.Paths[0])
+}
+
+// spelling returns the mention as an acronym or initialism of the releaser,
+// or false if the mention is a styled name or an alias.
+func spelling(path string, m mention.Mention) (initialism.Spelling, bool) {
+	for _, s := range initialism.Spellings(initialism.Path(path)) {
+		if s.Type != initialism.TypeAlias && strings.EqualFold(s.Value, m.Form) {
+			s.Value = m.Text
+			return s, true
+		}
+	}
+	return initialism.Spelling{}, false
+}
@@ -0,0 +1,77 @@
+package autolink_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Defacto2/releaser/autolink"
+	"github.com/Defacto2/releaser/mention"
+	"github.com/nalgeon/be"
+)
+
+func ExampleLink() {
+	s, _ := autolink.Link(`<p>Greets to TDT!</p>`, autolink.Options{Abbr: true})
+	fmt.Println(s)
+	// Output: <p>Greets to <a href="/g/the-dream-team"><abbr title="The Dream Team" aria-label="T D T">TDT</abbr></a>!</p>
+}
+
+func TestLink(t *testing.T) {
+	t.Parallel()
+	const tdt = `<a href="/g/the-dream-team">TDT</a>`
+	const flt = `<a href="/g/fairlight">Fairlight</a>`
+	tests := []struct {
+		name string
+		src  string
+		opts autolink.Options
+		want string
+	}{
+		{"empty", "", autolink.Options{}, ""},
+		{"no mentions", "<p>Hello &amp; world</p>", autolink.Options{}, "<p>Hello &amp; world</p>"},
+		{"mention", "<p>TDT</p>", autolink.Options{}, "<p>" + tdt + "</p>"},
+		{"attributes kept", `<p class="x" title="TDT">TDT</p>`, autolink.Options{}, `<p class="x" title="TDT">` + tdt + "</p>"},
+		{"escaped text", "<p>TDT &amp; Fairlight</p>", autolink.Options{}, "<p>" + tdt + " &amp; " + flt + "</p>"},
+		{"every", "TDT, TDT", autolink.Options{}, tdt + ", " + tdt},
+		{"first", "TDT, <b>TDT</b>", autolink.Options{First: true}, tdt + ", <b>TDT</b>"},
+		{"anchor", `<a href="/x">TDT</a>`, autolink.Options{}, `<a href="/x">TDT</a>`},
+		{"anchor nested", `<a href="/x"><b>TDT</b></a> TDT`, autolink.Options{}, `<a href="/x"><b>TDT</b></a> ` + tdt},
+		{"code", "<pre>TDT</pre><code>TDT</code>", autolink.Options{SkipCode: true}, "<pre>TDT</pre><code>TDT</code>"},
+		{"code linked", "<code>TDT</code>", autolink.Options{}, "<code>" + tdt + "</code>"},
+		{"script", "<script>var TDT = 1;</script>", autolink.Options{}, "<script>var TDT = 1;</script>"},
+		{"comment", "<!-- TDT -->", autolink.Options{}, "<!-- TDT -->"},
+		{"ambiguous", "<p>RZR</p>", autolink.Options{}, "<p>RZR</p>"},
+		{"word boundary", "<p>SPACE</p>", autolink.Options{}, "<p>SPACE</p>"},
+		{"special name", "<p>Defacto2 website</p>", autolink.Options{}, `<p><a href="/g/defacto2net">Defacto2 website</a></p>`},
+		{
+			"abbr", "<p>TDT and Fairlight</p>", autolink.Options{Abbr: true},
+			`<p><a href="/g/the-dream-team"><abbr title="The Dream Team" aria-label="T D T">TDT</abbr></a> and ` + flt + "</p>",
+		},
+		{
+			"abbr alias", "<p>FiRM</p>", autolink.Options{Abbr: true},
+			`<p><a href="/g/the-firm">FiRM</a></p>`,
+		},
+		{
+			"abbr acronym", "<p>NAPPA</p>", autolink.Options{Abbr: true},
+			`<p><a href="/g/north-american-pirate_phreak-association">` +
+				`<abbr title="North American Pirate-Phreak Association">NAPPA</abbr></a></p>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := autolink.Link(tt.src, tt.opts)
+			be.Err(t, err, nil)
+			be.Equal(t, got, tt.want)
+		})
+	}
+}
+
+func TestLinkPaths(t *testing.T) {
+	t.Parallel()
+	// every link points to the URL path that the mention resolved to, never to the form
+	for _, m := range mention.Find("Cracked by The Dream Team, Defacto2 website and ACiD Productions") {
+		got, err := autolink.Link(m.Text, autolink.Options{})
+		be.Err(t, err, nil)
+		be.True(t, strings.Contains(got, `href="`+autolink.Prefix+string(m.Paths[0])+`"`))
+	}
+}
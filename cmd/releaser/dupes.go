@@ -0,0 +1,59 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/Defacto2/releaser/dupe"
+)
+
+// dupes reports the clusters of likely duplicate releaser names.
+func dupes(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("dupes", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	isCSV := fs.Bool("csv", false, "read the names from a column of CSV records")
+	column := fs.Int("column", 0, "the zero-based CSV column of the names")
+	header := fs.Bool("header", false, "skip the first record of the input, ignoring any leading blank lines")
+	asJSON := fs.Bool("json", false, "print the clusters as JSON")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("dupes: %w", err)
+	}
+	r, closer, err := input(fs.Arg(0), stdin)
+	if err != nil {
+		return err
+	}
+	defer closer() //nolint:errcheck
+	var names []string
+	if *isCSV {
+		names, err = dupe.ReadCSV(r, *column, *header)
+	} else {
+		names, err = dupe.Read(r)
+		if *header && len(names) > 0 {
+			names = names[1:]
+		}
+	}
+	if err != nil {
+		return err
+	}
+	clusters := dupe.Clusters(names)
+	if *asJSON {
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(clusters) //nolint:wrapcheck
+	}
+	w := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+	for _, c := range clusters {
+		known := "new"
+		if c.Known {
+			known = "known"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d names\t%d listed\n", string(c.Path), known, len(c.Members), c.Count())
+		for _, m := range c.Members {
+			fmt.Fprintf(w, "  %s\t×%d\t%s\t%s\n", m.Name, m.Count, m.Reason, m.Note)
+		}
+	}
+	return w.Flush() //nolint:wrapcheck
+}
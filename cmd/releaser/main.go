@@ -0,0 +1,60 @@
+// Command releaser provides tools for the curators of the releaser names in a database.
+//
+// Usage:
+//
+//	releaser <command> [flags] [file]
+//
+// The commands are:
+//
+//	dupes    report the clusters of likely duplicate names
+//	migrate  write the SQL or CSV fix-ups of the values saved using earlier rules
+//
+// The names or the database export are read from the file, or from standard input when the file is omitted.
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+var (
+	errCommand = errors.New("unknown command")
+	errUsage   = errors.New("usage: releaser <command> [flags] [file]")
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// run runs the command named by the first argument.
+// The results are written to stdout, while the flag usage and errors are written to stderr.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+	switch args[0] {
+	case "dupes":
+		return dupes(args[1:], stdin, stdout, stderr)
+	case "migrate":
+		return migration(args[1:], stdin, stdout, stderr)
+	}
+	return fmt.Errorf("%w: %q", errCommand, args[0])
+}
+
+// input returns the reader of the named file, or stdin if the name is empty or "-".
+// The returned function closes the file.
+func input(name string, stdin io.Reader) (io.Reader, func() error, error) {
+	if name == "" || name == "-" {
+		return stdin, func() error { return nil }, nil
+	}
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, nil, fmt.Errorf("input: %w", err)
+	}
+	return f, f.Close, nil
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/nalgeon/be"
+)
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+	var out bytes.Buffer
+	be.True(t, errors.Is(run(nil, nil, &out, io.Discard), errUsage))
+	be.True(t, errors.Is(run([]string{"unknown"}, nil, &out, io.Discard), errCommand))
+
+	// the flag usage is written to stderr so it never mixes with the results
+	var stderr bytes.Buffer
+	be.True(t, errors.Is(run([]string{"dupes", "-h"}, nil, &out, &stderr), flag.ErrHelp))
+	be.Equal(t, out.String(), "")
+	be.True(t, strings.Contains(stderr.String(), "-column"))
+}
+
+func TestDupes(t *testing.T) {
+	t.Parallel()
+	var out bytes.Buffer
+	in := strings.NewReader("Razor 1911\nRAZOR1911\nFairlight\n")
+	be.Err(t, run([]string{"dupes"}, in, &out, io.Discard), nil)
+	be.True(t, strings.HasPrefix(out.String(), "razor-1911"))
+	be.True(t, strings.Contains(out.String(), "joined words"))
+	be.True(t, !strings.Contains(out.String(), "Fairlight"))
+
+	out.Reset()
+	in = strings.NewReader("id,name\n1,Razor 1911\n2,The Razor 1911\n")
+	be.Err(t, run([]string{"dupes", "-csv", "-column", "1", "-header", "-json"}, in, &out, io.Discard), nil)
+	be.True(t, strings.Contains(out.String(), `"Path": "razor-1911"`))
+	be.True(t, !strings.Contains(out.String(), `"name"`))
+
+	// the header is skipped before the blank values, so a blank header column keeps the first name
+	out.Reset()
+	in = strings.NewReader("\nid,\n1,Razor 1911\n2,The Razor 1911\n")
+	be.Err(t, run([]string{"dupes", "-csv", "-column", "1", "-header", "-json"}, in, &out, io.Discard), nil)
+	be.True(t, strings.Contains(out.String(), `"Name": "Razor 1911"`))
+
+	out.Reset()
+	in = strings.NewReader("\n\nname\nRazor 1911\nRAZOR1911\n")
+	be.Err(t, run([]string{"dupes", "-header"}, in, &out, io.Discard), nil)
+	be.True(t, strings.Contains(out.String(), "RAZOR1911"))
+}
+
+func TestDupesFile(t *testing.T) {
+	t.Parallel()
+	name := filepath.Join(t.TempDir(), "names.txt")
+	be.Err(t, os.WriteFile(name, []byte("Razor 1911\nrazor-1911\n"), 0o600), nil)
+	var out bytes.Buffer
+	be.Err(t, run([]string{"dupes", name}, nil, &out, io.Discard), nil)
+	be.True(t, strings.Contains(out.String(), "razor-1911"))
+	be.Err(t, run([]string{"dupes", name + ".missing"}, nil, &out, io.Discard))
+}
+
+func TestMigrate(t *testing.T) {
+	t.Parallel()
+	const export = "id\tname\n1\tRAZOR 1911\n2\trazor 1911\n"
+	var out bytes.Buffer
+	be.Err(t, run([]string{"migrate", "-tsv"}, strings.NewReader(export), &out, io.Discard), nil)
+	be.True(t, strings.Contains(out.String(), `UPDATE "groups" SET "name" = 'RAZOR 1911' WHERE "id" = 2;`))
+	be.True(t, !strings.Contains(out.String(), `"id" = 1;`))
+
+	out.Reset()
+	be.Err(t, run([]string{"migrate", "-tsv", "-format", "csv"}, strings.NewReader(export), &out, io.Discard), nil)
+	be.Equal(t, out.String(), "line,id,column,old,new\n3,2,name,razor 1911,RAZOR 1911\n")
+
+	be.True(t, errors.Is(run([]string{"migrate", "-format", "xml"}, nil, &out, io.Discard), errFormat))
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/Defacto2/releaser/migrate"
+)
+
+var errFormat = errors.New("unknown output format")
+
+// migration writes the SQL UPDATE statements or the CSV diff of the releaser values
+// of a database export that differ from their current form.
+func migration(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	tsv := fs.Bool("tsv", false, "read the export as tab separated values")
+	noHeader := fs.Bool("noheader", false, "the export has no header record of column names")
+	id := fs.Int("id", 0, "the zero-based column of the row ids")
+	idName := fs.String("idname", "", "the name of the id column, which defaults to the header")
+	cell := fs.Int("cell", 1, "the zero-based column of the values stored using releaser.Cell, or -1 for none")
+	cellName := fs.String("cellname", "", "the name of the cell column, which defaults to the header")
+	index := fs.Int("index", -1, "the zero-based column of the values stored using releaser.Index, or -1 for none")
+	indexName := fs.String("indexname", "", "the name of the index column, which defaults to the header")
+	table := fs.String("table", "groups", "the name of the database table")
+	format := fs.String("format", "sql", "the output format, either sql or csv")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("migrate: %w", err)
+	}
+	if *format != "sql" && *format != "csv" {
+		return fmt.Errorf("%w: %q", errFormat, *format)
+	}
+	opts := migrate.Options{Header: !*noHeader, ID: *id, IDName: *idName}
+	if *tsv {
+		opts.Comma = '\t'
+	}
+	if *cell >= 0 {
+		opts.Columns = append(opts.Columns, migrate.Column{Index: *cell, Name: *cellName, Form: migrate.Cell})
+	}
+	if *index >= 0 {
+		opts.Columns = append(opts.Columns, migrate.Column{Index: *index, Name: *indexName, Form: migrate.Index})
+	}
+	r, closer, err := input(fs.Arg(0), stdin)
+	if err != nil {
+		return err
+	}
+	defer closer() //nolint:errcheck
+	diff, err := migrate.Compare(r, opts)
+	if err != nil {
+		return err
+	}
+	if *format == "csv" {
+		return diff.WriteCSV(stdout) //nolint:wrapcheck
+	}
+	return diff.WriteSQL(stdout, *table) //nolint:wrapcheck
+}
@@ -0,0 +1,71 @@
+package releaser
+
+import (
+	"github.com/Defacto2/releaser/fix"
+	"golang.org/x/text/encoding/charmap"
+)
+
+// Decode returns the bytes decoded from the legacy code page to a UTF-8 string.
+// The graphic characters that are used as decoration, such as box-drawing and
+// block characters, are replaced with spaces.
+//
+// The cm charmap is the code page of the bytes, such as [charmap.CodePage437],
+// [charmap.CodePage850] or [charmap.ISO8859_1].
+// If cm is nil then the bytes are decoded as CP437, the code page of DOS text.
+//
+// Example:
+//
+//	Decode([]byte{0xcd, 0xb9, 'R', 'Z', 'R', 0xcc, 0xcd}, charmap.CodePage437) = "  RZR  "
+func Decode(b []byte, cm *charmap.Charmap) (string, error) {
+	if cm == nil {
+		cm = charmap.CodePage437
+	}
+	p, err := cm.NewDecoder().Bytes(b)
+	if err != nil {
+		return "", err
+	}
+	return fix.StripGraphics(string(p)), nil
+}
+
+// CellBytes decodes the bytes from the legacy code page and applies [releaser.Cell].
+// If the bytes cannot be decoded then an empty string is returned.
+//
+// Example:
+//
+//	CellBytes([]byte("\xb1\xb2\xdb the knightmare bbs \xdb\xb2\xb1"), nil) = "KNIGHTMARE BBS"
+func CellBytes(b []byte, cm *charmap.Charmap) string {
+	s, err := Decode(b, cm)
+	if err != nil {
+		return ""
+	}
+	return Cell(s)
+}
+
+// CleanBytes decodes the bytes from the legacy code page and applies [releaser.Clean].
+// If the bytes cannot be decoded then an empty string is returned.
+//
+// Example:
+//
+//	CleanBytes([]byte("\xb1\xb2\xdb the knightmare bbs \xdb\xb2\xb1"), nil) = "Knightmare BBS"
+//	CleanBytes([]byte("\x8eSTHETIC"), charmap.CodePage437) = "Ästhetic"
+func CleanBytes(b []byte, cm *charmap.Charmap) string {
+	s, err := Decode(b, cm)
+	if err != nil {
+		return ""
+	}
+	return Clean(s)
+}
+
+// TitleBytes decodes the bytes from the legacy code page and applies [releaser.Title].
+// If the bytes cannot be decoded then an empty string is returned.
+//
+// Example:
+//
+//	TitleBytes([]byte("\xc4\xc4\xb4 nappa \xc3\xc4\xc4"), nil) = "North American Pirate-Phreak Association"
+func TitleBytes(b []byte, cm *charmap.Charmap) string {
+	s, err := Decode(b, cm)
+	if err != nil {
+		return ""
+	}
+	return Title(s)
+}
@@ -0,0 +1,60 @@
+package releaser_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Defacto2/releaser"
+	"github.com/nalgeon/be"
+	"golang.org/x/text/encoding/charmap"
+)
+
+func ExampleCleanBytes() {
+	// CP437 encoded bytes of "░▒█ the knightmare bbs █▒░"
+	b := []byte("\xb0\xb1\xdb the knightmare bbs \xdb\xb1\xb0")
+	fmt.Println(releaser.CleanBytes(b, charmap.CodePage437))
+	// Output: Knightmare BBS
+}
+
+func ExampleDecode() {
+	s, _ := releaser.Decode([]byte("\xcd\xb9RZR\xcc\xcd"), nil)
+	fmt.Printf("%q\n", s)
+	// Output: "  RZR  "
+}
+
+func TestDecode(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		b    []byte
+		cm   *charmap.Charmap
+		want string
+	}{
+		{"empty", nil, nil, ""},
+		{"ascii", []byte("Razor 1911"), nil, "Razor 1911"},
+		{"cp437 box", []byte("\xc9\xcdRazor\xcd\xbb"), charmap.CodePage437, "  Razor  "},
+		{"cp437 blocks", []byte("\xb0\xb1\xb2\xdbX\xdf\xdc"), nil, "    X  "},
+		{"cp437 letters", []byte("\x8e\x99\x9a"), charmap.CodePage437, "ÄÖÜ"},
+		{"cp437 symbols", []byte("\x03\x04\x0e X \xf8\xf9\xfa"), charmap.CodePage437, "    X    "},
+		{"cp850", []byte("Caf\x82"), charmap.CodePage850, "Café"},
+		{"latin-1", []byte("Caf\xe9 \xabX\xbb"), charmap.ISO8859_1, "Café  X "},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := releaser.Decode(tt.b, tt.cm)
+			be.Err(t, err, nil)
+			be.Equal(t, got, tt.want)
+		})
+	}
+}
+
+func TestCleanBytes(t *testing.T) {
+	t.Parallel()
+	// CP437 box-drawing characters must not be kept as Latin-1 letters such as Ä
+	b := []byte("\xc4\xc4\xb4 razor 1911 \xc3\xc4\xc4")
+	be.Equal(t, releaser.CleanBytes(b, charmap.CodePage437), "Razor 1911")
+	be.Equal(t, releaser.CleanBytes(b, charmap.ISO8859_1), "Ää Razor 1911 Ãää")
+	be.Equal(t, releaser.CellBytes(b, nil), "RAZOR 1911")
+	be.Equal(t, releaser.TitleBytes([]byte("\xdb\xdb nappa \xdb\xdb"), nil), "North American Pirate-Phreak Association")
+}
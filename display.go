@@ -0,0 +1,188 @@
+package releaser
+
+import (
+	"html"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Defacto2/releaser/initialism"
+)
+
+// A Style is the display format of a releaser name.
+type Style uint8
+
+// The display styles of a releaser name.
+const (
+	Long                  Style = iota // Long is the humanized name, e.g. "The Dream Team".
+	Short                              // Short is the best initialism or the humanized name, e.g. "TDT".
+	LongWithInitialism                 // LongWithInitialism is the humanized name followed by the initialism, e.g. "The Dream Team (TDT)".
+	InitialismWithTooltip              // InitialismWithTooltip is an HTML <abbr> element of the initialism with the name as its title.
+)
+
+// ShortMax is the maximum number of characters of an initialism used by the [Short] style.
+const ShortMax = 6
+
+const (
+	ellipsis    = "…"  // ellipsis is appended to the truncated names.
+	spacedComma = ", " // spacedComma separates the members of a cooperation.
+)
+
+// memberSeparators are the separators of the cooperation members in the humanized names,
+// see [Humanize], [Index] and [Link], and in the URL paths.
+var memberSeparators = [...]string{", ", " / ", " + ", "*"} //nolint:gochecknoglobals
+
+// Initialism returns the best initialism for the URL path, or an empty string if there is none.
+// The initialisms and acronyms are preferred over the aliases, and only spellings of
+// a single word of up to [ShortMax] characters are used. The choice is deterministic,
+// with the earlier listed spellings of the same type used first.
+//
+// Example:
+//
+//	Initialism("the-dream-team") = "TDT"
+//	Initialism("the-firm") = "FRM"
+//	Initialism("razor-1911") = "RZR"
+//	Initialism("The-Dream-Team") = "TDT"
+func Initialism(path string) string {
+	return short(path).Value
+}
+
+// short returns the best initialism for the URL path with its listed type,
+// or an empty spelling if there is none, see [Initialism].
+// The URL path is resolved the same way as [Humanize].
+func short(path string) initialism.Spelling {
+	p := string(resolve(path))
+	spellings := slices.DeleteFunc(initialism.Spellings(initialism.Path(p)), func(s initialism.Spelling) bool {
+		return utf8.RuneCountInString(s.Value) > ShortMax || strings.Contains(s.Value, " ") ||
+			strings.EqualFold(s.Value, p)
+	})
+	if len(spellings) == 0 {
+		return initialism.Spelling{}
+	}
+	slices.SortStableFunc(spellings, func(a, b initialism.Spelling) int {
+		return b.Type.Weight() - a.Type.Weight()
+	})
+	return spellings[0]
+}
+
+// Display returns the releaser name of the URL path formatted using the style.
+// If the URL path has no initialism then the [Short] and [InitialismWithTooltip]
+// styles return the humanized name.
+// If the URL path contains invalid characters then an empty string is returned.
+//
+// Example:
+//
+//	Display("the-dream-team", Long) = "The Dream Team"
+//	Display("the-dream-team", Short) = "TDT"
+//	Display("the-dream-team", LongWithInitialism) = "The Dream Team (TDT)"
+//	Display("the-dream-team", InitialismWithTooltip) = `<abbr title="The Dream Team" aria-label="T D T">TDT</abbr>`
+func Display(path string, style Style) string {
+	long := Humanize(path)
+	if long == "" {
+		return ""
+	}
+	abbr := short(path)
+	short := abbr.Value
+	switch style {
+	case Short:
+		if short != "" {
+			return short
+		}
+	case LongWithInitialism:
+		if short != "" && !strings.EqualFold(short, long) {
+			return long + " (" + short + ")"
+		}
+	case InitialismWithTooltip:
+		if short == "" {
+			return html.EscapeString(long)
+		}
+		if abbr.Type == initialism.TypeAlias {
+			// a short alias is read as it is written, but still gets the tooltip
+			abbr.Type = initialism.TypeAcronym
+		}
+		return abbr.Abbr(long)
+	case Long:
+	}
+	return long
+}
+
+// DisplayWidth returns the releaser name of the URL path formatted using the style,
+// and truncated to fit within the width of characters.
+//
+// Names are truncated at word boundaries and end with an ellipsis.
+// Cooperations are truncated by dropping the whole members that do not fit,
+// and the initialism of the [LongWithInitialism] style is dropped before the name is truncated.
+// The [InitialismWithTooltip] style is HTML and is never truncated.
+//
+// Example:
+//
+//	DisplayWidth("the-dream-team", LongWithInitialism, 16) = "The Dream Team"
+//	DisplayWidth("class*paradigm*razor-1911", Long, 18) = "Class, Paradigm…"
+//	DisplayWidth("north-american-pirate_phreak-association", Long, 20) = "North American…"
+func DisplayWidth(path string, style Style, width int) string {
+	s := Display(path, style)
+	if style == InitialismWithTooltip || utf8.RuneCountInString(s) <= width {
+		return s
+	}
+	if style == LongWithInitialism {
+		s = Display(path, Long)
+		if utf8.RuneCountInString(s) <= width {
+			return s
+		}
+	}
+	return Truncate(s, width)
+}
+
+// Truncate shortens the name to fit within the width of characters, ending with an ellipsis.
+// The name is cut at a word boundary, and the members of a cooperation are kept whole
+// unless the first member is too long. The members can be separated by any of the
+// cooperation separators, such as ", ", " / " or " + ".
+//
+// Example:
+//
+//	Truncate("Class, Paradigm, Razor 1911", 18) = "Class, Paradigm…"
+//	Truncate("TDT + TRSi + Razor 1911", 12) = "TDT + TRSi…"
+//	Truncate("North American Pirate-Phreak Association", 20) = "North American…"
+func Truncate(s string, width int) string {
+	if utf8.RuneCountInString(s) <= width {
+		return s
+	}
+	if width < 1 {
+		return ""
+	}
+	limit := width - utf8.RuneCountInString(ellipsis)
+	fits := func(x string) bool {
+		return utf8.RuneCountInString(x) <= limit
+	}
+	first, cut := s, 0
+	for i := 1; i < len(s); i++ {
+		for _, sep := range memberSeparators {
+			if !strings.HasPrefix(s[i:], sep) {
+				continue
+			}
+			if first == s {
+				first = s[:i]
+			}
+			if fits(s[:i]) {
+				cut = i
+			}
+		}
+	}
+	if cut > 0 {
+		return s[:cut] + ellipsis
+	}
+	words := strings.Fields(first)
+	keep := ""
+	for _, word := range words {
+		next := strings.TrimSpace(keep + " " + word)
+		if !fits(next) {
+			break
+		}
+		keep = next
+	}
+	if keep == "" {
+		runes := []rune(s)
+		keep = string(runes[:max(limit, 0)])
+	}
+	return strings.TrimRight(keep, " ,&-") + ellipsis
+}
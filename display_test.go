@@ -0,0 +1,135 @@
+package releaser_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Defacto2/releaser"
+	"github.com/nalgeon/be"
+)
+
+func ExampleDisplay() {
+	fmt.Println(releaser.Display("the-dream-team", releaser.Long))
+	fmt.Println(releaser.Display("the-dream-team", releaser.Short))
+	fmt.Println(releaser.Display("the-dream-team", releaser.LongWithInitialism))
+	fmt.Println(releaser.Display("the-dream-team", releaser.InitialismWithTooltip))
+	// Output: The Dream Team
+	// TDT
+	// The Dream Team (TDT)
+	// <abbr title="The Dream Team" aria-label="T D T">TDT</abbr>
+}
+
+func ExampleDisplayWidth() {
+	fmt.Println(releaser.DisplayWidth("class*paradigm*razor-1911", releaser.Long, 18))
+	// Output: Class, Paradigm…
+}
+
+func TestInitialism(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"", ""},
+		{"the-dream-team", "TDT"},
+		{"The-Dream-Team", "TDT"},
+		{"thedreamteam", "TDT"},
+		{"the-firm", "FRM"},
+		{"razor-1911", "RZR"},
+		{"tristar-ampersand-red-sector-inc", "TRSi"},
+		{"class*paradigm*razor-1911", ""},
+		{"some-random-bbs", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			t.Parallel()
+			be.Equal(t, releaser.Initialism(tt.path), tt.want)
+		})
+	}
+}
+
+func TestDisplay(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		path  string
+		style releaser.Style
+		want  string
+	}{
+		{"", releaser.Long, ""},
+		{"razor-1911-demo#trsi", releaser.Short, ""},
+		{"razor-1911", releaser.Long, "Razor 1911"},
+		{"razor-1911", releaser.Short, "RZR"},
+		{"The-Dream-Team", releaser.Short, "TDT"},
+		{"The-Dream-Team", releaser.LongWithInitialism, "The Dream Team (TDT)"},
+		{"razor-1911", releaser.LongWithInitialism, "Razor 1911 (RZR)"},
+		{"some-random-bbs", releaser.Short, "Some Random BBS"},
+		{"some-random-bbs", releaser.LongWithInitialism, "Some Random BBS"},
+		{"some-random-bbs", releaser.InitialismWithTooltip, "Some Random BBS"},
+		{
+			"tristar-ampersand-red-sector-inc", releaser.InitialismWithTooltip,
+			`<abbr title="Tristar &amp; Red Sector Inc" aria-label="T R S I">TRSi</abbr>`,
+		},
+		{
+			"north-american-pirate_phreak-association", releaser.InitialismWithTooltip,
+			`<abbr title="North American Pirate-Phreak Association">NAPPA</abbr>`,
+		},
+		{"class*paradigm*razor-1911", releaser.Short, "Class, Paradigm, Razor 1911"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			t.Parallel()
+			be.Equal(t, releaser.Display(tt.path, tt.style), tt.want)
+		})
+	}
+}
+
+func TestDisplayWidth(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		path  string
+		style releaser.Style
+		width int
+		want  string
+	}{
+		{"fits", "the-dream-team", releaser.LongWithInitialism, 20, "The Dream Team (TDT)"},
+		{"drop initialism", "the-dream-team", releaser.LongWithInitialism, 16, "The Dream Team"},
+		{"words", "the-dream-team", releaser.Long, 12, "The Dream…"},
+		{"members", "class*paradigm*razor-1911", releaser.Long, 18, "Class, Paradigm…"},
+		{"first member", "class*paradigm*razor-1911", releaser.Long, 6, "Class…"},
+		{"long member", "north-american-pirate_phreak-association*class", releaser.Long, 20, "North American…"},
+		{"long word", "north-american-pirate_phreak-association", releaser.Long, 4, "Nor…"},
+		{"short", "the-dream-team", releaser.Short, 2, "T…"},
+		{"tooltip", "the-dream-team", releaser.InitialismWithTooltip, 2, `<abbr title="The Dream Team" aria-label="T D T">TDT</abbr>`},
+		{"zero", "the-dream-team", releaser.Long, 0, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			be.Equal(t, releaser.DisplayWidth(tt.path, tt.style, tt.width), tt.want)
+		})
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		s     string
+		width int
+		want  string
+	}{
+		{"Class, Paradigm, Razor 1911", 18, "Class, Paradigm…"},
+		{"TDT + TRSi + Razor 1911", 12, "TDT + TRSi…"},
+		{"TDT / TRSi / Razor 1911", 12, "TDT / TRSi…"},
+		{"TDT + TRSi, Razor 1911", 20, "TDT + TRSi…"},
+		{"The Dream Team + TRSi", 12, "The Dream…"},
+		{"OB/GYN Crew", 8, "OB/GYN…"},
+		{"TDT + TRSi", 10, "TDT + TRSi"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+			be.Equal(t, releaser.Truncate(tt.s, tt.width), tt.want)
+		})
+	}
+}
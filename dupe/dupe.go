@@ -0,0 +1,356 @@
+// Package dupe groups the free-text releaser names of a database into clusters
+// of likely duplicates, such as "Razor 1911", "RAZOR1911" and "The Razor 1911",
+// so that curators can review them and merge the records.
+package dupe
+
+import (
+	"bufio"
+	"cmp"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/Defacto2/releaser"
+	"github.com/Defacto2/releaser/initialism"
+	"github.com/Defacto2/releaser/name"
+)
+
+// ErrColumn is returned when the CSV column of the names is missing from a record.
+var ErrColumn = errors.New("the csv column is out of range")
+
+// A Reason is the evidence that a name belongs to a cluster.
+type Reason uint8
+
+// The reasons that a name is grouped into a cluster.
+const (
+	SameKey      Reason = iota // SameKey is the same releaser.Key, so the names differ only by case, punctuation, diacritics or "The".
+	JoinedWords                // JoinedWords is the same releaser.Key after the joined-up words of the name were segmented.
+	Initialism                 // Initialism is a known initialism, acronym or alternative spelling of the cluster.
+	EditDistance               // EditDistance is a small number of typing differences from a key of the cluster.
+)
+
+// String returns the description of the reason.
+func (r Reason) String() string {
+	switch r {
+	case SameKey:
+		return "same key"
+	case JoinedWords:
+		return "joined words"
+	case Initialism:
+		return "initialism"
+	case EditDistance:
+		return "edit distance"
+	}
+	return ""
+}
+
+// A Member is a distinct name within a cluster.
+type Member struct {
+	Name   string // Name is the name as it is written in the list.
+	Key    string // Key is the releaser.Key of the name.
+	Count  int    // Count is the number of times the name is listed.
+	Reason Reason // Reason is the evidence that the name belongs to the cluster.
+	Note   string // Note describes the reason, such as the key or initialism that was matched.
+}
+
+// A Cluster is a group of names that are likely duplicates of the same releaser.
+type Cluster struct {
+	Path    name.Path // Path is the suggested canonical URL path of the releaser.
+	Known   bool      // Known is true if the path belongs to a known releaser.
+	Members []Member  // Members are the distinct names, the most listed first.
+}
+
+// Count returns the number of times the names of the cluster are listed.
+func (c Cluster) Count() int {
+	n := 0
+	for _, m := range c.Members {
+		n += m.Count
+	}
+	return n
+}
+
+// Read returns the names listed one per line, skipping blank lines.
+func Read(r io.Reader) ([]string, error) {
+	names := []string{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if s := strings.TrimSpace(scanner.Text()); s != "" {
+			names = append(names, s)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("dupe read: %w", err)
+	}
+	return names, nil
+}
+
+// ReadCSV returns the names listed in the zero-based column of the CSV records, skipping blank values.
+// The CSV records may have a varying number of fields. If header is true then the first record
+// is skipped, even when its column is blank.
+func ReadCSV(r io.Reader, column int, header bool) ([]string, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("dupe read csv: %w", err)
+	}
+	names := []string{}
+	for i, record := range records {
+		if header && i == 0 {
+			continue
+		}
+		if column < 0 || column >= len(record) {
+			return nil, fmt.Errorf("%w: column %d of line %d", ErrColumn, column, i+1)
+		}
+		if s := strings.TrimSpace(record[column]); s != "" {
+			names = append(names, s)
+		}
+	}
+	return names, nil
+}
+
+// A group is the distinct names that share the same key.
+type group struct {
+	key    string
+	names  map[string]int
+	count  int
+	known  name.Path // known is the URL path of the known releaser of the merged groups.
+	parent int       // parent is the index of the group that this group was merged into.
+	reason Reason    // reason is the evidence used to merge this group into its parent.
+	note   string
+}
+
+// Clusters groups the names into clusters of likely duplicates.
+// Only clusters with two or more distinct names are returned, and they are sorted
+// by the most listed cluster first.
+//
+// Names are first grouped using [releaser.Key], and then the groups are merged
+// when one is a unique initialism match of another or the keys are within a small edit distance.
+// The edit distance is never used for short keys or keys with different numbers,
+// and a cluster never contains more than one known releaser.
+func Clusters(names []string) []Cluster {
+	groups, byKey := groupKeys(names)
+	var find func(i int) int
+	find = func(i int) int {
+		if groups[i].parent != i {
+			groups[i].parent = find(groups[i].parent)
+		}
+		return groups[i].parent
+	}
+	union := func(i, j int, reason Reason, note string) {
+		a, b := find(i), find(j)
+		if a == b || (groups[a].known != "" && groups[b].known != "" && groups[a].known != groups[b].known) {
+			return
+		}
+		if groups[b].count > groups[a].count || (groups[b].count == groups[a].count && b < a) {
+			a, b = b, a
+		}
+		groups[b].parent, groups[b].reason, groups[b].note = a, reason, note
+		if groups[a].known == "" {
+			groups[a].known = groups[b].known
+		}
+	}
+	for i, g := range groups {
+		if j, value, found := initialismOf(g, byKey); found && j != i {
+			union(i, j, Initialism, value+" of "+groups[j].key)
+		}
+	}
+	for i := range groups {
+		for j := i + 1; j < len(groups); j++ {
+			if d, ok := similar(groups[i].key, groups[j].key); ok {
+				union(j, i, EditDistance, fmt.Sprintf("%d between %s and %s", d, groups[i].key, groups[j].key))
+			}
+		}
+	}
+	clusters := map[int][]int{}
+	for i := range groups {
+		root := find(i)
+		clusters[root] = append(clusters[root], i)
+	}
+	result := []Cluster{}
+	for root, members := range clusters {
+		c := cluster(groups, root, members)
+		if len(c.Members) > 1 {
+			result = append(result, c)
+		}
+	}
+	slices.SortFunc(result, func(a, b Cluster) int {
+		if n := cmp.Compare(b.Count(), a.Count()); n != 0 {
+			return n
+		}
+		return cmp.Compare(a.Path, b.Path)
+	})
+	return result
+}
+
+// groupKeys returns the names grouped by their key, in the order of the first listed name,
+// and the index of each group keyed by its key. Names without a key are skipped.
+func groupKeys(names []string) ([]group, map[string]int) {
+	groups := []group{}
+	byKey := map[string]int{}
+	for _, s := range names {
+		s = strings.Join(strings.Fields(s), " ")
+		key := releaser.Key(s)
+		if key == "" {
+			continue
+		}
+		i, found := byKey[key]
+		if !found {
+			i = len(groups)
+			byKey[key] = i
+			groups = append(groups, group{key: key, names: map[string]int{}, known: paths[key], parent: i})
+		}
+		groups[i].names[s]++
+		groups[i].count++
+	}
+	return groups, byKey
+}
+
+// initialismOf returns the index of the group with the key of the only known releaser
+// that uses one of the names of the group as an initialism.
+func initialismOf(g group, byKey map[string]int) (int, string, bool) {
+	for _, s := range slices.Sorted(maps.Keys(g.names)) {
+		matches := map[int]bool{}
+		for _, path := range initialism.Match(s) {
+			if j, found := byKey[releaser.Key(string(path))]; found {
+				matches[j] = true
+			}
+		}
+		if len(matches) == 1 {
+			for j := range matches {
+				return j, s, true
+			}
+		}
+	}
+	return 0, "", false
+}
+
+// similar returns the edit distance of the keys and true if the keys are likely to be
+// the same name with a typing mistake.
+// A leading "the" is ignored, as it is only kept in the keys of some known releasers.
+func similar(a, b string) (int, bool) {
+	const minRunes, long = 6, 12
+	a, b = strings.TrimPrefix(a, "the"), strings.TrimPrefix(b, "the")
+	na, nb := utf8.RuneCountInString(a), utf8.RuneCountInString(b)
+	if min(na, nb) < minRunes || digits(a) != digits(b) {
+		return 0, false
+	}
+	limit := 1
+	if min(na, nb) >= long {
+		limit = 2
+	}
+	if max(na, nb)-min(na, nb) > limit {
+		return 0, false
+	}
+	d := distance(a, b)
+	return d, d <= limit
+}
+
+// paths are a cache of the URL paths of the known releasers keyed by their releaser.Key.
+// When known releasers share a key, the first sorted URL path is used.
+var paths = func() map[string]name.Path { //nolint:gochecknoglobals
+	all := slices.Collect(maps.Keys(*name.Special()))
+	for path := range *initialism.Initialisms() {
+		all = append(all, name.Path(path))
+	}
+	slices.Sort(all)
+	m := map[string]name.Path{}
+	for _, path := range all {
+		key := releaser.Key(string(path))
+		if _, found := m[key]; !found {
+			m[key] = path
+		}
+	}
+	return m
+}()
+
+// digits returns the digits of the string.
+func digits(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+// distance returns the optimal string alignment edit distance of the strings,
+// which is the Levenshtein distance that also counts the transposition of two adjacent letters as one edit.
+func distance(a, b string) int {
+	x, y := []rune(a), []rune(b)
+	d := make([][]int, len(x)+1)
+	for i := range d {
+		d[i] = make([]int, len(y)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(x); i++ {
+		for j := 1; j <= len(y); j++ {
+			cost := 1
+			if x[i-1] == y[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && x[i-1] == y[j-2] && x[i-2] == y[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(x)][len(y)]
+}
+
+// words returns the number of words in the name, which are separated by any character that is not a letter or digit.
+func words(s string) int {
+	return len(strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}))
+}
+
+// cluster returns the cluster of the groups with the suggested canonical path.
+func cluster(groups []group, root int, indexes []int) Cluster {
+	c := Cluster{}
+	for _, i := range indexes {
+		g := groups[i]
+		most := 0
+		for s := range g.names {
+			most = max(most, words(s))
+		}
+		for s, count := range g.names {
+			m := Member{Name: s, Key: g.key, Count: count, Reason: SameKey, Note: g.key}
+			switch {
+			case i != root:
+				m.Reason, m.Note = g.reason, g.note
+			case words(s) > 1:
+			case len(initialism.Match(s)) > 0:
+				m.Reason, m.Note = Initialism, s+" of "+g.key
+			case most > 1:
+				m.Reason = JoinedWords
+			}
+			c.Members = append(c.Members, m)
+		}
+	}
+	slices.SortFunc(c.Members, func(a, b Member) int {
+		if n := cmp.Compare(b.Count, a.Count); n != 0 {
+			return n
+		}
+		return cmp.Compare(a.Name, b.Name)
+	})
+	for _, m := range c.Members {
+		if path, found := paths[m.Key]; found {
+			c.Path, c.Known = path, true
+			break
+		}
+	}
+	if c.Path == "" && len(c.Members) > 0 {
+		c.Path = name.Path(releaser.Obfuscate(c.Members[0].Name))
+	}
+	return c
+}
@@ -0,0 +1,105 @@
+package dupe_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Defacto2/releaser/dupe"
+	"github.com/Defacto2/releaser/name"
+	"github.com/nalgeon/be"
+)
+
+func ExampleClusters() {
+	names := []string{"Razor 1911", "RAZOR1911", "The Razor 1911", "RZR", "Razor 1911", "Fairlight"}
+	for _, c := range dupe.Clusters(names) {
+		fmt.Println(string(c.Path), c.Count())
+		for _, m := range c.Members {
+			fmt.Printf("  %s (%s)\n", m.Name, m.Reason)
+		}
+	}
+	// Output: razor-1911 5
+	//   Razor 1911 (same key)
+	//   RAZOR1911 (joined words)
+	//   RZR (initialism)
+	//   The Razor 1911 (same key)
+}
+
+func TestClusters(t *testing.T) {
+	t.Parallel()
+	be.Equal(t, len(dupe.Clusters(nil)), 0)
+	be.Equal(t, len(dupe.Clusters([]string{"Razor 1911", "Razor 1911", "  "})), 0)
+
+	c := dupe.Clusters([]string{"The Dream Team", "TDT", "The Dream Taem", "Dream Team"})
+	be.Equal(t, len(c), 1)
+	be.Equal(t, c[0].Path, name.Path("the-dream-team"))
+	be.True(t, c[0].Known)
+	be.Equal(t, len(c[0].Members), 3)
+	reasons := map[string]dupe.Reason{}
+	for _, m := range c[0].Members {
+		reasons[m.Name] = m.Reason
+	}
+	be.Equal(t, reasons["TDT"], dupe.Initialism)
+	be.Equal(t, reasons["The Dream Taem"], dupe.EditDistance)
+
+	c = dupe.Clusters([]string{"Some Random Group", "Some Random Group", "Some Randon Group"})
+	be.Equal(t, len(c), 1)
+	be.Equal(t, c[0].Path, name.Path("some-random-group"))
+	be.True(t, !c[0].Known)
+	be.Equal(t, c[0].Count(), 3)
+	be.Equal(t, c[0].Members[1].Reason, dupe.EditDistance)
+}
+
+func TestClustersApart(t *testing.T) {
+	t.Parallel()
+	// different numbers, short keys and different known releasers are never clustered
+	tests := [][]string{
+		{"Razor 1911", "Razor 1912"},
+		{"Fairlight 2", "Fairlight 3"},
+		{"ABCD", "ABCE"},
+		{"Razor 1911", "Razor 1911 Demo"},
+		{"The Dream Team", "Dream Team"},
+	}
+	for _, names := range tests {
+		t.Run(strings.Join(names, "+"), func(t *testing.T) {
+			t.Parallel()
+			be.Equal(t, len(dupe.Clusters(names)), 0)
+		})
+	}
+}
+
+func TestReason(t *testing.T) {
+	t.Parallel()
+	be.Equal(t, dupe.SameKey.String(), "same key")
+	be.Equal(t, dupe.JoinedWords.String(), "joined words")
+	be.Equal(t, dupe.Initialism.String(), "initialism")
+	be.Equal(t, dupe.EditDistance.String(), "edit distance")
+	be.Equal(t, dupe.Reason(99).String(), "")
+}
+
+func TestRead(t *testing.T) {
+	t.Parallel()
+	names, err := dupe.Read(strings.NewReader("Razor 1911\n\n  RAZOR1911  \r\n"))
+	be.Err(t, err, nil)
+	be.Equal(t, names, []string{"Razor 1911", "RAZOR1911"})
+}
+
+func TestReadCSV(t *testing.T) {
+	t.Parallel()
+	const s = "1,\"Razor 1911, TRSi\"\n2,RAZOR1911\n3,\n"
+	names, err := dupe.ReadCSV(strings.NewReader(s), 1, false)
+	be.Err(t, err, nil)
+	be.Equal(t, names, []string{"Razor 1911, TRSi", "RAZOR1911"})
+	names, err = dupe.ReadCSV(strings.NewReader(s), 1, true)
+	be.Err(t, err, nil)
+	be.Equal(t, names, []string{"RAZOR1911"})
+	// a header with a blank column must not drop the first name
+	names, err = dupe.ReadCSV(strings.NewReader("\nid,\n1,Razor 1911\n"), 1, true)
+	be.Err(t, err, nil)
+	be.Equal(t, names, []string{"Razor 1911"})
+	_, err = dupe.ReadCSV(strings.NewReader(s), 2, false)
+	be.True(t, errors.Is(err, dupe.ErrColumn))
+	_, err = dupe.ReadCSV(strings.NewReader("\"unterminated\n"), 0, false)
+	be.Err(t, err)
+}
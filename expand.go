@@ -0,0 +1,198 @@
+package releaser
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/Defacto2/releaser/initialism"
+	"github.com/Defacto2/releaser/name"
+)
+
+// A Source is where an equivalent form of a search query came from.
+type Source uint8
+
+// The sources of the equivalent forms.
+const (
+	SourceQuery       Source = iota // SourceQuery is the search query as it was typed.
+	SourcePath                      // SourcePath is the URL path of the releaser, e.g. "the-dream-team".
+	SourceName                      // SourceName is the styled or humanized name, e.g. "The Dream Team".
+	SourceInitialism                // SourceInitialism is an initialism, acronym or alternative spelling, e.g. "TDT".
+	SourceCell                      // SourceCell is the database cell form returned by [Cell], e.g. "THE DREAM TEAM".
+	SourceIndex                     // SourceIndex is the database index form returned by [Index].
+	SourceCooperation               // SourceCooperation is the name of a cooperation that includes the releaser, e.g. "TDT / TRSi".
+)
+
+// String returns the name of the source.
+func (s Source) String() string {
+	switch s {
+	case SourceQuery:
+		return "query"
+	case SourcePath:
+		return "path"
+	case SourceName:
+		return "name"
+	case SourceInitialism:
+		return "initialism"
+	case SourceCell:
+		return "cell"
+	case SourceIndex:
+		return "index"
+	case SourceCooperation:
+		return "cooperation"
+	}
+	return ""
+}
+
+// Weight returns the search weight of the source, so the query and the names of the
+// releaser rank above its initialisms, and those rank above the cooperations that include it.
+func (s Source) Weight() int {
+	const low, mid, high = 1, 2, 3
+	switch s {
+	case SourceQuery, SourcePath, SourceName:
+		return high
+	case SourceInitialism, SourceCell, SourceIndex:
+		return mid
+	case SourceCooperation:
+		return low
+	}
+	return low
+}
+
+// An Equivalent is a form of a search query that refers to the same releaser.
+type Equivalent struct {
+	Value  string    // Value is the form to search for.
+	Source Source    // Source is where the form came from.
+	Path   name.Path // Path is the URL path of the releaser or cooperation of the form.
+}
+
+// cooperations are a lazy cache of the URL paths of the known cooperations keyed by the URL
+// paths of their members, which is used by Expand.
+var cooperations = sync.OnceValue(func() map[name.Path][]name.Path { //nolint:gochecknoglobals
+	m := make(map[name.Path][]name.Path)
+	add := func(member, coop name.Path) {
+		if member != coop && paths[member] && !slices.Contains(m[member], coop) {
+			m[member] = append(m[member], coop)
+		}
+	}
+	for coop := range paths {
+		if strings.Contains(string(coop), "*") {
+			for member := range strings.SplitSeq(string(coop), "*") {
+				add(name.Path(member), coop)
+			}
+		}
+		forms := slices.Clone(initialism.Initialism(initialism.Path(coop)))
+		if special, found := (*specials)[coop]; found {
+			forms = append(forms, special)
+		}
+		for _, form := range forms {
+			parts := strings.FieldsFunc(form, func(r rune) bool {
+				return r == '/' || r == '+' || r == ','
+			})
+			const members = 2
+			if len(parts) < members {
+				continue
+			}
+			for _, part := range parts {
+				part = strings.TrimSpace(part)
+				if p, found := resolves[strings.ToLower(part)]; found {
+					add(p, coop)
+					continue
+				}
+				add(obfuscate(part), coop)
+			}
+		}
+	}
+	for member := range m {
+		slices.Sort(m[member])
+	}
+	return m
+})
+
+// Expand returns the equivalent forms of the search query, so a search for "tdt"
+// can also match "The Dream Team", "the-dream-team", "THE DREAM TEAM" or "TDT / TRSi".
+// Every form is tagged with its source, which can be used to build OR queries
+// or to weigh the forms of a full-text query, see [Source.Weight].
+//
+// The query is resolved to the URL paths of the releasers using the special names,
+// initialisms and URL paths. An initialism that is used by more than one releaser,
+// such as "RZR", is expanded to the forms of every releaser that uses it.
+// Each releaser is expanded to its URL path, name, initialisms, [Cell] and [Index] forms,
+// and the names and URL paths of the known cooperations that include it.
+// Forms with the same value are only listed once, using their first source.
+// If the query has no letters or numbers then nil is returned.
+//
+// Example:
+//
+//	Expand("tdt") = []Equivalent{
+//		{"tdt", SourceQuery, "the-dream-team"},
+//		{"the-dream-team", SourcePath, "the-dream-team"},
+//		{"The Dream Team", SourceName, "the-dream-team"},
+//		{"TDT", SourceInitialism, "the-dream-team"},
+//		{"THE DREAM TEAM", SourceCell, "the-dream-team"},
+//		{"TDT / TRSi", SourceCooperation, "coop"},
+//		...
+//	}
+func Expand(query string) []Equivalent {
+	query = strings.TrimSpace(query)
+	matches := expansions(query)
+	if len(matches) == 0 {
+		return nil
+	}
+	forms := []Equivalent{}
+	add := func(value string, source Source, path name.Path) {
+		if value == "" || slices.ContainsFunc(forms, func(e Equivalent) bool { return e.Value == value }) {
+			return
+		}
+		forms = append(forms, Equivalent{Value: value, Source: source, Path: path})
+	}
+	add(query, SourceQuery, matches[0])
+	for _, path := range matches {
+		add(string(path), SourcePath, path)
+		humanized := Humanize(string(path))
+		add(humanized, SourceName, path)
+		for _, value := range initialism.Initialism(initialism.Path(path)) {
+			add(value, SourceInitialism, path)
+		}
+		add(Cell(humanized), SourceCell, path)
+		add(Index(string(path)), SourceIndex, path)
+	}
+	for _, path := range matches {
+		for _, coop := range cooperations()[path] {
+			add(Humanize(string(coop)), SourceCooperation, coop)
+			add(string(coop), SourceCooperation, coop)
+		}
+	}
+	return forms
+}
+
+// expansions returns the URL paths of the releasers for the search query.
+func expansions(query string) []name.Path {
+	if Key(query) == "" {
+		return nil
+	}
+	for path, special := range *specials {
+		if strings.EqualFold(query, special) {
+			return []name.Path{path}
+		}
+	}
+	if found := initialism.Match(query); len(found) > 0 {
+		matches := make([]name.Path, 0, len(found))
+		for _, path := range found {
+			matches = append(matches, name.Path(path))
+		}
+		slices.Sort(matches)
+		return matches
+	}
+	p := name.Path(strings.ToLower(query))
+	if !p.Valid() || !paths[p] {
+		p = obfuscate(strings.TrimSpace(query))
+	}
+	if !paths[p] && strings.IndexFunc(string(p), separator) < 0 {
+		if joined, found := Unjoin(string(p)); found {
+			p = joined
+		}
+	}
+	p, _ = name.Canonical(p)
+	return []name.Path{p}
+}
@@ -0,0 +1,92 @@
+package releaser_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/Defacto2/releaser"
+	"github.com/Defacto2/releaser/name"
+	"github.com/nalgeon/be"
+)
+
+func ExampleExpand() {
+	for _, form := range releaser.Expand("tdt")[:6] {
+		fmt.Printf("%s: %s\n", form.Source, form.Value)
+	}
+	// Output: query: tdt
+	// path: the-dream-team
+	// name: The Dream Team
+	// initialism: TDT
+	// cell: THE DREAM TEAM
+	// cooperation: TDT / TRSi
+}
+
+func TestExpand(t *testing.T) {
+	t.Parallel()
+	be.Equal(t, len(releaser.Expand("")), 0)
+	be.Equal(t, len(releaser.Expand("-=[ ]=-")), 0)
+
+	forms := releaser.Expand("tdt")
+	values := map[string]releaser.Source{}
+	for _, f := range forms {
+		_, dupe := values[f.Value]
+		be.True(t, !dupe)
+		values[f.Value] = f.Source
+	}
+	be.Equal(t, values["tdt"], releaser.SourceQuery)
+	be.Equal(t, values["the-dream-team"], releaser.SourcePath)
+	be.Equal(t, values["The Dream Team"], releaser.SourceName)
+	be.Equal(t, values["TDT"], releaser.SourceInitialism)
+	be.Equal(t, values["THE DREAM TEAM"], releaser.SourceCell)
+	be.Equal(t, values["TDT / TRSi"], releaser.SourceCooperation)
+	be.Equal(t, values["coop"], releaser.SourceCooperation)
+
+	forms = releaser.Expand("some random group")
+	be.Equal(t, forms, []releaser.Equivalent{
+		{Value: "some random group", Source: releaser.SourceQuery, Path: "some-random-group"},
+		{Value: "some-random-group", Source: releaser.SourcePath, Path: "some-random-group"},
+		{Value: "Some Random Group", Source: releaser.SourceName, Path: "some-random-group"},
+		{Value: "SOME RANDOM GROUP", Source: releaser.SourceCell, Path: "some-random-group"},
+	})
+}
+
+func TestExpandAmbiguous(t *testing.T) {
+	t.Parallel()
+	// an initialism used by several releasers expands to all of them
+	paths := []name.Path{}
+	for _, f := range releaser.Expand("RZR") {
+		if f.Source == releaser.SourcePath {
+			paths = append(paths, f.Path)
+		}
+	}
+	be.Equal(t, paths, []name.Path{"razor-1911", "razor-1911-demo", "razordox"})
+	be.True(t, slices.ContainsFunc(releaser.Expand("razor1911"), func(e releaser.Equivalent) bool {
+		return e.Value == "Razor 1911" && e.Source == releaser.SourceName
+	}))
+}
+
+func TestSource(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		source releaser.Source
+		name   string
+		weight int
+	}{
+		{releaser.SourceQuery, "query", 3},
+		{releaser.SourcePath, "path", 3},
+		{releaser.SourceName, "name", 3},
+		{releaser.SourceInitialism, "initialism", 2},
+		{releaser.SourceCell, "cell", 2},
+		{releaser.SourceIndex, "index", 2},
+		{releaser.SourceCooperation, "cooperation", 1},
+		{releaser.Source(99), "", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			be.Equal(t, tt.source.String(), tt.name)
+			be.Equal(t, tt.source.Weight(), tt.weight)
+		})
+	}
+}
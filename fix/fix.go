@@ -4,8 +4,11 @@ package fix
 import (
 	"fmt"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/Defacto2/releaser/name"
 	"golang.org/x/text/cases"
@@ -66,6 +69,198 @@ func Amp(s string) string {
 	return x
 }
 
+// Collapse joins the runs of three or more single letters or digits that are separated by
+// spaces or dots into words. A run of digits that follows a run of letters becomes a new word.
+// Two or more spaces are treated as the break between runs.
+// Dotted acronyms with a trailing dot, such as "G.O.D.", are kept.
+//
+// Example:
+//
+//	Collapse("R A Z O R  1 9 1 1") = "RAZOR  1911"
+//	Collapse("R A Z O R 1 9 1 1") = "RAZOR 1911"
+//	Collapse("F.A.I.R.L.I.G.H.T") = "FAIRLIGHT"
+//	Collapse("S. W. A. T. Crew") = "SWAT Crew"
+//	Collapse("G.O.D. Network") = "G.O.D. Network"
+func Collapse(s string) string {
+	const minimum = 3
+	tokens := strings.Split(s, space)
+	for i, token := range tokens {
+		tokens[i] = undot(token)
+	}
+	collapsed := make([]string, 0, len(tokens))
+	run := []string{}
+	flush := func() {
+		if len(run) >= minimum {
+			collapsed = append(collapsed, split(strings.Join(run, "")))
+		} else {
+			collapsed = append(collapsed, run...)
+		}
+		run = run[:0]
+	}
+	for _, token := range tokens {
+		if letter := strings.TrimSuffix(token, "."); utf8.RuneCountInString(letter) == 1 &&
+			alphanumeric([]rune(letter)[0]) {
+			run = append(run, letter)
+			continue
+		}
+		flush()
+		collapsed = append(collapsed, token)
+	}
+	flush()
+	return strings.Join(collapsed, space)
+}
+
+// undot removes the dots from a word made of two or more single letters or digits
+// that are separated by dots, otherwise the word is returned unchanged.
+// Words with a trailing dot are dotted acronyms, such as "G.O.D.", and are also returned unchanged.
+//
+// Example:
+//
+//	undot("F.A.I.R.L.I.G.H.T") = "FAIRLIGHT"
+//	undot("G.O.D.") = "G.O.D."
+//	undot("e.mail") = "e.mail"
+func undot(w string) string {
+	if strings.HasSuffix(w, ".") {
+		return w
+	}
+	letters := strings.Split(w, ".")
+	const minimum = 2
+	if len(letters) < minimum {
+		return w
+	}
+	for _, letter := range letters {
+		if utf8.RuneCountInString(letter) != 1 || !alphanumeric([]rune(letter)[0]) {
+			return w
+		}
+	}
+	return strings.Join(letters, "")
+}
+
+// Dotted formats the dotted acronyms and the domain names that are kept with their dots.
+// Acronyms are uppercased with a trailing dot and domain names only capitalize the first letter.
+// Otherwise it returns an empty string.
+//
+// Example:
+//
+//	Dotted("g.o.d") = "G.O.D."
+//	Dotted("scene.org") = "Scene.org"
+//	Dotted("DEFACTO2.NET") = "Defacto2.net"
+//	Dotted("e.mail") = ""
+func Dotted(w string) string {
+	if !strings.Contains(w, ".") {
+		return ""
+	}
+	labels := strings.Split(strings.TrimSuffix(strings.ToLower(w), "."), ".")
+	const minimum = 2
+	if len(labels) < minimum {
+		return ""
+	}
+	acronym := true
+	for _, label := range labels {
+		if label == "" || strings.IndexFunc(label, func(r rune) bool {
+			return (r < 'a' || r > 'z') && (r < '0' || r > '9')
+		}) >= 0 {
+			return ""
+		}
+		if utf8.RuneCountInString(label) != 1 || !unicode.IsLetter([]rune(label)[0]) {
+			acronym = false
+		}
+	}
+	if acronym {
+		return strings.ToUpper(strings.Join(labels, ".")) + "."
+	}
+	if !name.TopLevelDomain(labels[len(labels)-1]) {
+		return ""
+	}
+	if labels[0] != "www" {
+		title := cases.Title(language.English)
+		labels[0] = title.String(labels[0])
+	}
+	return strings.Join(labels, ".")
+}
+
+// split inserts a space between a run of letters and the run of digits that follows it.
+func split(w string) string {
+	var b strings.Builder
+	prev := rune(0)
+	for _, r := range w {
+		if prev != 0 && unicode.IsLetter(prev) && unicode.IsDigit(r) {
+			b.WriteString(space)
+		}
+		b.WriteRune(r)
+		prev = r
+	}
+	return b.String()
+}
+
+// Apostrophe restores the apostrophes of the contractions and possessives in the lowercased name,
+// as the apostrophes are removed from the URL paths.
+// Known contractions such as "dont" are always restored, while the listed possessives,
+// such as "devils" or "franks", are only restored when they are not the last word.
+// Other words that end with "s" are never guessed to be possessive.
+//
+// Example:
+//
+//	Apostrophe("devils realm bbs") = "devil's realm bbs"
+//	Apostrophe("franks palace") = "frank's palace"
+//	Apostrophe("rogues gallery") = "rogues' gallery"
+//	Apostrophe("dont copy that floppy") = "don't copy that floppy"
+//	Apostrophe("pirates cove") = "pirates cove"
+func Apostrophe(s string) string {
+	words := strings.Split(s, space)
+	last := len(words) - 1
+	for i, word := range words {
+		if strings.Contains(word, "'") {
+			continue
+		}
+		if fix, found := contractions()[word]; found {
+			words[i] = fix
+			continue
+		}
+		if i == last {
+			continue
+		}
+		if fix, found := possessives()[word]; found {
+			words[i] = fix
+		}
+	}
+	return strings.Join(words, space)
+}
+
+// contractions are the words that are always written with an apostrophe.
+func contractions() map[string]string {
+	return map[string]string{
+		"aint": "ain't", "cant": "can't", "couldnt": "couldn't", "didnt": "didn't",
+		"doesnt": "doesn't", "dont": "don't", "isnt": "isn't", "oclock": "o'clock",
+		"shouldnt": "shouldn't", "thats": "that's", "theyre": "they're", "wasnt": "wasn't",
+		"whats": "what's", "wont": "won't", "wouldnt": "wouldn't", "youre": "you're",
+	}
+}
+
+// possessives are the words that are written with an apostrophe when they are not the last word,
+// which are taken from the curated spellings of the known releasers.
+func possessives() map[string]string {
+	return map[string]string{
+		"blackmaxs":   "blackmax's",
+		"boners":      "boner's",
+		"carpenters":  "carpenter's",
+		"demons":      "demon's",
+		"devils":      "devil's",
+		"dragons":     "dragon's",
+		"edies":       "edie's",
+		"fastjacks":   "fastjack's",
+		"franks":      "frank's",
+		"marauders":   "marauder's",
+		"pjs":         "pj's",
+		"programmers": "programmer's",
+		"ravers":      "raver's",
+		"razors":      "razor's",
+		"retaliators": "retaliator's",
+		"rogues":      "rogues'",
+		"spyrits":     "spyrit's",
+	}
+}
+
 // Connect formats common connecting word as the w string based on its position in a words slice.
 func Connect(w string, position, last int) string {
 	const first = 0
@@ -91,10 +286,15 @@ func Cell(s string) string {
 	groups := strings.Split(s, ",")
 	for index, group := range groups {
 		fullname := strings.ToLower(strings.TrimSpace(group))
+		fullname = strings.ReplaceAll(fullname, "'", "")
 		fullname = Amp(fullname)
 		words := strings.Split(fullname, space)
 		last := len(words) - 1
 		for i, word := range words {
+			if fix := Dotted(word); fix != "" {
+				words[i] = fix
+				continue
+			}
 			word = TrimDot(word)
 			if fix := Hyphen(word); fix != "" {
 				words[i] = fix
@@ -163,9 +363,14 @@ func Format(s string) string {
 			groups[index] = special
 			continue
 		}
+		fullname = Apostrophe(fullname)
 		words := strings.Split(fullname, space)
 		last := len(words) - 1
 		for i, word := range words {
+			if fix := Dotted(word); fix != "" {
+				words[i] = fix
+				continue
+			}
 			word = TrimDot(word)
 			if fix := Hyphen(word); fix != "" {
 				words[i] = fix
@@ -228,16 +433,231 @@ func Sequence(w string, i int) string {
 	return ""
 }
 
+// SplitCase inserts spaces into the CamelCase words and between the letters and digits that follow them.
+// Uppercase letters are only split when they begin a new word that is followed by a lowercase letter,
+// so the elite styled names such as "ACiD" or "MiRROR" are kept as is.
+// Digits that are followed by letters are not split, so "2000AD" is kept as is.
+//
+// Example:
+//
+//	SplitCase("DefactoTwo") = "Defacto Two"
+//	SplitCase("ShitOnlyGerman") = "Shit Only German"
+//	SplitCase("Razor1911") = "Razor 1911"
+//	SplitCase("ACiD") = "ACiD"
+func SplitCase(s string) string {
+	runes := []rune(s)
+	var b strings.Builder
+	for i, r := range runes {
+		if i > 0 {
+			prev := runes[i-1]
+			camel := unicode.IsLower(prev) && unicode.IsUpper(r) &&
+				i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			digit := unicode.IsLetter(prev) && unicode.IsDigit(r)
+			if camel || digit {
+				b.WriteString(space)
+			}
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
 // StripChars removes all the incompatible characters that cannot be used for releaser URL paths.
+// Apostrophes are kept and typographic quotes are replaced with apostrophes.
+// Dotted acronyms and domain names, such as "G.O.D." or "scene.org", are kept with their dots,
+// even when they are wrapped in other incompatible characters.
 //
 // Example:
 //
 //	StripChars("Café!") = "Café"
+//	StripChars("Defacto2.net!") = "Defacto2.net"
+//	StripChars("(defacto2.net)") = "defacto2.net"
+//	StripChars("Devil’s Realm") = "Devil's Realm"
 //	StripChars(".~[[@]hello[@]]~.") = "hello"
 func StripChars(s string) string {
-	const validChars = `[^A-Za-zÀ-ÖØ-öø-ÿ0-9\-,& ]`
+	const validChars = `[^A-Za-zÀ-ÖØ-öø-ÿ0-9\-,&' ]`
 	r := regexp.MustCompile(validChars)
-	return r.ReplaceAllString(s, "")
+	dots := regexp.MustCompile(`[^A-Za-zÀ-ÖØ-öø-ÿ0-9\-,&'. ]`)
+	s = strings.NewReplacer("’", "'", "‘", "'", "`", "'").Replace(s)
+	words := strings.Split(s, space)
+	for i, word := range words {
+		dotted := strings.TrimLeft(dots.ReplaceAllString(word, ""), ".")
+		if d := Dotted(dotted); d != "" {
+			if !strings.HasSuffix(d, ".") {
+				dotted = strings.TrimSuffix(dotted, ".")
+			}
+			words[i] = dotted
+			continue
+		}
+		words[i] = r.ReplaceAllString(word, "")
+	}
+	return strings.Join(words, space)
+}
+
+// StripEnds removes the decoration from both ends of the string.
+// Decorations include bracket frames, symbols, repeated filler characters and
+// symmetric ornaments that mirror each other, such as "-=xX" and "Xx=-".
+//
+// Example:
+//
+//	StripEnds("_.=[   RaZoR 1911   ]=._") = "RaZoR 1911"
+//	StripEnds("-=xX Razor Xx=-") = "Razor"
+//	StripEnds(".oO Fairlight Oo.") = "Fairlight"
+//	StripEnds("///dR///") = "dR"
+//	StripEnds("xxxxx Razor xxxxx") = "Razor"
+func StripEnds(s string) string {
+	return StripEndsKnown(s, nil)
+}
+
+// StripEndsKnown is the conservative version of [fix.StripEnds] that never removes
+// the characters belonging to a known name. The known func reports whether the
+// string is a known name, and when it is, the string is returned without further changes.
+// If known is nil then no names are known.
+//
+// Example:
+//
+//	StripEndsKnown("[ TDU Jam! ]", isKnown) = "TDU Jam!"
+//	StripEndsKnown("Scorpion ¥", isKnown) = "Scorpion ¥"
+//	StripEndsKnown("[G.O.D.]", isKnown) = "G.O.D."
+func StripEndsKnown(s string, known func(string) bool) string {
+	if known == nil {
+		known = func(string) bool { return false }
+	}
+	x := strings.TrimSpace(s)
+	for x != "" && !known(x) {
+		fields := strings.Fields(x)
+		first, last := fields[0], fields[len(fields)-1]
+		switch {
+		case len(fields) > 1 && ornament(first):
+			x = strings.Join(fields[1:], space)
+		case len(fields) > 1 && ornament(last):
+			x = strings.Join(fields[:len(fields)-1], space)
+		case len(fields) > 2 && !known(first) && !known(last) &&
+			(mirror(first, last) || filler(first, last)):
+			x = strings.Join(fields[1:len(fields)-1], space)
+		default:
+			trim := strings.TrimFunc(x, func(r rune) bool { return !alphanumeric(r) })
+			if dotted(trim, x) {
+				trim += "."
+			}
+			if trim == x {
+				return x
+			}
+			x = trim
+		}
+	}
+	return x
+}
+
+// dotted returns true if the trimmed string ends with a dotted acronym, such as "G.O.D",
+// whose closing dot follows it in the untrimmed string.
+func dotted(trim, s string) bool {
+	i := strings.Index(s, trim)
+	if trim == "" || i < 0 || !strings.HasPrefix(s[i+len(trim):], ".") {
+		return false
+	}
+	fields := strings.Fields(trim)
+	return strings.HasSuffix(Dotted(fields[len(fields)-1]), ".")
+}
+
+// alphanumeric returns true if r is a letter or a digit.
+func alphanumeric(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// ornament returns true if the word has no letters or digits.
+func ornament(w string) bool {
+	return strings.IndexFunc(w, alphanumeric) < 0
+}
+
+// mirror returns true if the last word is the mirror image of the first word,
+// and the first word is an ornament that only uses symbols and the letters x and o.
+//
+// Example:
+//
+//	mirror("-=xX", "Xx=-") = true
+//	mirror("[oO", "Oo]") = true
+func mirror(first, last string) bool {
+	for _, r := range first {
+		if alphanumeric(r) && !strings.ContainsRune("xXoO", r) {
+			return false
+		}
+	}
+	if ornament(first) || utf8.RuneCountInString(first) < 2 {
+		return false
+	}
+	runes := []rune(first)
+	slices.Reverse(runes)
+	for i, r := range runes {
+		runes[i] = opposite(r)
+	}
+	return string(runes) == last
+}
+
+// opposite returns the mirror image of the bracket or slash character, otherwise r is returned.
+func opposite(r rune) rune {
+	pairs := map[rune]rune{
+		'[': ']', ']': '[', '(': ')', ')': '(', '{': '}', '}': '{',
+		'<': '>', '>': '<', '/': '\\', '\\': '/',
+	}
+	if o, found := pairs[r]; found {
+		return o
+	}
+	return r
+}
+
+// filler returns true if the first and last words are the same character repeated three or more times.
+//
+// Example:
+//
+//	filler("xxxxx", "xxxx") = true
+func filler(first, last string) bool {
+	const repeats = 3
+	if utf8.RuneCountInString(first) < repeats || utf8.RuneCountInString(last) < repeats {
+		return false
+	}
+	r, _ := utf8.DecodeRuneInString(first)
+	same := func(c rune) bool { return c == r }
+	return strings.TrimFunc(first, same) == "" && strings.TrimFunc(last, same) == ""
+}
+
+// StripGraphics replaces the control, box-drawing, block, shading and other graphic characters
+// with spaces. These characters are commonly used as decoration in DOS text that has been
+// decoded from the CP437 code page.
+//
+// Example:
+//
+//	StripGraphics("═══╣RAZOR 1911╠═══") = "    RAZOR 1911    "
+//	StripGraphics("░▒▓ Fairlight ▓▒░") = "    Fairlight    "
+func StripGraphics(s string) string {
+	return strings.Map(func(r rune) rune {
+		if Graphic(r) {
+			return ' '
+		}
+		return r
+	}, s)
+}
+
+// Graphic returns true if r is a control or graphic character that is used as decoration.
+func Graphic(r rune) bool {
+	if unicode.IsControl(r) {
+		return true
+	}
+	switch {
+	case r == '«', r == '¬', r == '°', r == '±', r == '²', r == '·', r == '»', r == '÷':
+		return true
+	case r == '•', r == '‼', r == 'ⁿ', r == '∙', r == '√', r == '∞', r == '∩', r == '≈', r == '≡':
+		return true
+	case r == '≤', r == '≥', r == '⌐', r == '⌠', r == '⌡':
+		return true
+	case r >= 0x2190 && r <= 0x21FF: // arrows
+		return true
+	case r >= 0x2500 && r <= 0x25FF: // box drawing, block elements and geometric shapes
+		return true
+	case r >= 0x2600 && r <= 0x26FF: // miscellaneous symbols such as ☺ ♥ ♪
+		return true
+	}
+	return false
 }
 
 // StripStart removes the non-alphanumeric characters from the start of the string.
@@ -59,6 +59,16 @@ func ExampleStripChars() {
 	// Output: OMG-WTF
 }
 
+func ExampleStripGraphics() {
+	fmt.Printf("%q", fix.StripGraphics("░▒▓ Fairlight ▓▒░"))
+	// Output: "    Fairlight    "
+}
+
+func ExampleStripEnds() {
+	fmt.Println(fix.StripEnds("-=xX Razor 1911 Xx=-"))
+	// Output: Razor 1911
+}
+
 func ExampleTrimSP() {
 	fmt.Print(fix.TrimSP("            hello              world        "))
 	// Output: hello world
@@ -203,6 +213,66 @@ func TestCell(t *testing.T) {
 	}
 }
 
+func TestDotted(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		w    string
+		want string
+	}{
+		{"empty", "", ""},
+		{"word", "razor", ""},
+		{"trailing dot", "inc.", ""},
+		{"acronym", "g.o.d", "G.O.D."},
+		{"acronym trailing dot", "G.O.D.", "G.O.D."},
+		{"domain", "scene.org", "Scene.org"},
+		{"uppercase domain", "DEFACTO2.NET", "Defacto2.net"},
+		{"subdomain", "www.scene.org", "www.scene.org"},
+		{"unknown domain", "e.mail", ""},
+		{"version", "1.2", ""},
+		{"symbols", "scene!.org", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := fix.Dotted(tt.w); got != tt.want {
+				t.Errorf("Dotted(%q) = %q, want %q", tt.w, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApostrophe(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"empty", "", ""},
+		{"possessive", "devils realm bbs", "devil's realm bbs"},
+		{"leading the", "the marauders hideout bbs", "the marauder's hideout bbs"},
+		{"plural possessive", "rogues gallery bbs", "rogues' gallery bbs"},
+		{"contraction", "dont copy that floppy", "don't copy that floppy"},
+		{"last word", "dont stop the pirates", "don't stop the pirates"},
+		{"not a place", "pirates crew", "pirates crew"},
+		{"plural word", "lost souls domain", "lost souls domain"},
+		{"short word", "its realm", "its realm"},
+		{"not listed", "pirates cove", "pirates cove"},
+		{"not listed edge", "the gamers edge", "the gamers edge"},
+		{"listed last word", "the devils", "the devils"},
+		{"kept", "devil's realm", "devil's realm"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := fix.Apostrophe(tt.s); got != tt.want {
+				t.Errorf("Apostrophe(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestConnect(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -246,7 +316,15 @@ func Test_StripChars(t *testing.T) {
 		{"", args{"A Café!"}, "A Café"},
 		{"", args{"brunräven - över"}, "brunräven - över"},
 		{"", args{".~[Hello]~."}, "Hello"},
-		{"", args{"defacto2.net"}, "defacto2net"},
+		{"", args{"defacto2.net"}, "defacto2.net"},
+		{"", args{"(defacto2.net)"}, "defacto2.net"},
+		{"", args{"Defacto2.net!"}, "Defacto2.net"},
+		{"", args{"[scene.org]."}, "scene.org"},
+		{"", args{"(G.O.D.)"}, "G.O.D."},
+		{"", args{"red.dot!"}, "reddot"},
+		{"", args{"G.O.D. Network!"}, "G.O.D. Network"},
+		{"", args{"Devil's Realm"}, "Devil's Realm"},
+		{"", args{"Devil’s Realm"}, "Devil's Realm"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -286,6 +364,158 @@ func Test_StripStart(t *testing.T) {
 	}
 }
 
+func Test_StripEnds(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"empty", "", ""},
+		{"only decoration", "-=[ ]=-", ""},
+		{"plain", "Razor 1911", "Razor 1911"},
+		{"title example", "_.=[   RaZoR 1911   ]=._", "RaZoR 1911"},
+		{"frame", "[Razor 1911]", "Razor 1911"},
+		{"xX ornament", "-=xX Razor Xx=-", "Razor"},
+		{"oO ornament", ".oO Fairlight Oo.", "Fairlight"},
+		{"bare ornament", "xX Fairlight Xx", "Fairlight"},
+		{"bracket ornament", "[oO Fairlight Oo]", "Fairlight"},
+		{"attached", "///dR///", "dR"},
+		{"filler", "xxxxx Razor xxxxx", "Razor"},
+		{"dashes", "----- Razor -----", "Razor"},
+		{"one sided", "Razor 1911 ---", "Razor 1911"},
+		{"not mirrored", "Xx Razor Xx", "Xx Razor Xx"},
+		{"not an ornament", "Abba Razor abbA", "Abba Razor abbA"},
+		{"inner symbols", "TDT / TRSi", "TDT / TRSi"},
+		{"trailing symbol", "TDU Jam!", "TDU Jam"},
+		{"dotted acronym", "G.O.D.", "G.O.D."},
+		{"framed dotted acronym", "[M.Y.T.H.]", "M.Y.T.H."},
+		{"dotted acronym ornament", "-=xX G.O.D. Xx=-", "G.O.D."},
+		{"trailing dot", "Razor 1911.", "Razor 1911"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := fix.StripEnds(tt.s); got != tt.want {
+				t.Errorf("StripEnds(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCollapse(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"empty", "", ""},
+		{"plain", "Razor 1911", "Razor 1911"},
+		{"spaced", "S W A T", "SWAT"},
+		{"spaced words", "R A Z O R  1 9 1 1", "RAZOR  1911"},
+		{"spaced digits", "R A Z O R 1 9 1 1", "RAZOR 1911"},
+		{"dotted", "F.A.I.R.L.I.G.H.T", "FAIRLIGHT"},
+		{"dotted pair", "A.C", "AC"},
+		{"trailing dot", "A.C.E.", "A.C.E."},
+		{"dotted acronym", "G.O.D. Network", "G.O.D. Network"},
+		{"spaced dots", "S. W. A. T. Crew", "SWAT Crew"},
+		{"too short", "X B BBS", "X B BBS"},
+		{"not letters", "- - -", "- - -"},
+		{"dotted word", "e.mail", "e.mail"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := fix.Collapse(tt.s); got != tt.want {
+				t.Errorf("Collapse(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitCase(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"empty", "", ""},
+		{"plain", "razor", "razor"},
+		{"camel case", "DefactoTwo", "Defacto Two"},
+		{"three words", "ShitOnlyGerman", "Shit Only German"},
+		{"letters and digits", "Razor1911", "Razor 1911"},
+		{"digits and letters", "2000AD", "2000AD"},
+		{"elite", "ACiD", "ACiD"},
+		{"elite mixed", "MiRROR", "MiRROR"},
+		{"words", "Razor 1911 Demo", "Razor 1911 Demo"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := fix.SplitCase(tt.s); got != tt.want {
+				t.Errorf("SplitCase(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_StripEndsKnown(t *testing.T) {
+	t.Parallel()
+	known := func(s string) bool {
+		switch strings.ToLower(s) {
+		case "tdu jam!", "scorpion ¥", "xx":
+			return true
+		}
+		return false
+	}
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"empty", "", ""},
+		{"known", "TDU Jam!", "TDU Jam!"},
+		{"known framed", "[ TDU Jam! ]", "TDU Jam!"},
+		{"known symbol", "Scorpion ¥", "Scorpion ¥"},
+		{"known ornament", "xX Razor Xx", "xX Razor Xx"},
+		{"unknown", "-=xX Razor Xx=-", "Razor"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := fix.StripEndsKnown(tt.s, known); got != tt.want {
+				t.Errorf("StripEndsKnown(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_StripGraphics(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"empty", "", ""},
+		{"text", "Café Ästhetic ¥", "Café Ästhetic ¥"},
+		{"box", "╔═╗Razor╚═╝", "   Razor   "},
+		{"blocks", "░▒▓█▄▀", "      "},
+		{"symbols", "☺♥♪►•°·", "       "},
+		{"control", "a\x01b\x1bc", "a b c"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := fix.StripGraphics(tt.s); got != tt.want {
+				t.Errorf("StripGraphics() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_TrimSP(t *testing.T) {
 	t.Parallel()
 	type args struct {
@@ -0,0 +1,101 @@
+package releaser
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/Defacto2/releaser/initialism"
+	"github.com/Defacto2/releaser/name"
+)
+
+// A cellForm is the styled form of a known releaser that is used by FromCell.
+type cellForm struct {
+	name      string
+	path      name.Path
+	ambiguous bool // ambiguous is true if the cell form is shared by different releasers.
+}
+
+// cellForms are a lazy cache of the styled names and initialisms of the known releasers
+// keyed by their [Cell] form, which is used by FromCell. The names of the releasers
+// are listed before the initialisms, so an initialism never replaces a name.
+var cellForms = sync.OnceValue(func() map[string]cellForm { //nolint:gochecknoglobals
+	names := make(map[string]cellForm, len(paths))
+	add := func(m map[string]cellForm, s string, path name.Path) {
+		key := Cell(s)
+		if key == "" {
+			return
+		}
+		if f, found := m[key]; found {
+			if f.path != path {
+				f.ambiguous = true
+				m[key] = f
+			}
+			return
+		}
+		m[key] = cellForm{name: s, path: path}
+	}
+	inits := make(map[string]cellForm)
+	for path := range paths {
+		add(names, Humanize(string(path)), path)
+		for _, value := range (*initialisms)[initialism.Path(path)] {
+			add(inits, value, path)
+		}
+	}
+	for key, f := range inits {
+		if _, found := names[key]; !found {
+			names[key] = f
+		}
+	}
+	return names
+})
+
+// FromCell returns the styled name and the URL path of a value stored using [Cell].
+// The special names, the names of the known releasers and their initialisms are used
+// to restore the styling, so "ACID PRODUCTIONS" returns "ACiD Productions" and
+// an initialism such as "ACID" returns "ACiD".
+// The members of a comma separated cooperation are restored individually,
+// and a cell that is the uppercased URL path of a known releaser, such as a
+// domain name stored with or without its dots, returns the humanized name of that path.
+//
+// The known result is true if the name was found in the dictionaries,
+// otherwise the name is formatted using [Clean] and should be reviewed.
+// A cell form that is shared by different releasers, such as the "RZR" initialism,
+// is not restored from the dictionaries.
+//
+// Example:
+//
+//	FromCell("ACID PRODUCTIONS") = "ACiD Productions", "acid-productions", true
+//	FromCell("TDT TRSI") = "TDT / TRSi", "coop", true
+//	FromCell("RAZOR 1911, TRSI") = "Razor 1911, TRSi", "razor-1911*trsi", true
+//	FromCell("DEFACTO2NET") = "Defacto2 website", "defacto2net", true
+//	FromCell("DEFACTO2 DEMO GROUP") = "Defacto2 Demo Group", "defacto2-demo-group", false
+func FromCell(s string) (string, name.Path, bool) {
+	key := Cell(s)
+	if key == "" {
+		return "", "", false
+	}
+	if f, found := cellForms()[key]; found && !f.ambiguous {
+		return f.name, f.path, true
+	}
+	members := strings.Split(key, spacedComma)
+	if len(members) > 1 {
+		names := make([]string, 0, len(members))
+		paths := make([]string, 0, len(members))
+		known := true
+		for _, member := range members {
+			n, p, ok := FromCell(member)
+			if n == "" {
+				continue
+			}
+			names = append(names, n)
+			paths = append(paths, string(p))
+			known = known && ok
+		}
+		return strings.Join(names, spacedComma), name.Path(strings.Join(paths, "*")), known
+	}
+	if p := undotted(name.Path(strings.ToLower(key))); paths[p] {
+		return Humanize(string(p)), p, true
+	}
+	x := Clean(key)
+	return x, obfuscate(x), false
+}
@@ -0,0 +1,64 @@
+package releaser_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Defacto2/releaser"
+	"github.com/Defacto2/releaser/name"
+	"github.com/nalgeon/be"
+)
+
+func ExampleFromCell() {
+	s, path, known := releaser.FromCell("ACID PRODUCTIONS")
+	fmt.Println(s, string(path), known)
+	s, path, known = releaser.FromCell("DEFACTO2 DEMO GROUP")
+	fmt.Println(s, string(path), known)
+	// Output: ACiD Productions acid-productions true
+	// Defacto2 Demo Group defacto2-demo-group false
+}
+
+func TestFromCell(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		cell  string
+		name  string
+		path  name.Path
+		known bool
+	}{
+		{"", "", "", false},
+		{"-=[ ]=-", "", "", false},
+		{"ACID PRODUCTIONS", "ACiD Productions", "acid-productions", true},
+		{"acid productions", "ACiD Productions", "acid-productions", true},
+		{"ACID", "ACiD", "acid-productions", true},
+		{"THE DREAM TEAM", "The Dream Team", "the-dream-team", true},
+		{"TDT TRSI", "TDT / TRSi", "coop", true},
+		{"RAZOR 1911, TRSI", "Razor 1911, TRSi", "razor-1911*trsi", true},
+		{"FAIRLIGHT, SOME GROUP", "Fairlight, Some Group", "fairlight*some-group", false},
+		{"DEFACTO2 DEMO GROUP", "Defacto2 Demo Group", "defacto2-demo-group", false},
+		{"RZR", "RZR", "rzr", false},                             // ambiguous initialism
+		{"DEFACTO2NET", "Defacto2 website", "defacto2net", true}, // stored without its dots
+		{"DEFACTO2.NET", "Defacto2 website", "defacto2net", true},
+		{"RAZOR-1911-DEMO", "Razor 1911 Demo", "razor-1911-demo", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.cell, func(t *testing.T) {
+			t.Parallel()
+			n, p, known := releaser.FromCell(tt.cell)
+			be.Equal(t, n, tt.name)
+			be.Equal(t, p, tt.path)
+			be.Equal(t, known, tt.known)
+		})
+	}
+}
+
+func TestFromCellRoundTrip(t *testing.T) {
+	t.Parallel()
+	for _, path := range []string{"acid-productions", "razor-1911", "the-dream-team", "coop", "defacto2"} {
+		s := releaser.Humanize(path)
+		n, p, known := releaser.FromCell(releaser.Cell(s))
+		be.Equal(t, n, s)
+		be.Equal(t, p, name.Path(path))
+		be.True(t, known)
+	}
+}
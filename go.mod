@@ -4,6 +4,7 @@ go 1.26.4
 
 require (
 	github.com/nalgeon/be v0.3.0
+	golang.org/x/net v0.54.0
 	golang.org/x/text v0.38.0
 )
 
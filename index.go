@@ -0,0 +1,172 @@
+package releaser
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/Defacto2/releaser/name"
+)
+
+// An Order is the order of the members of a cooperation in an index key.
+type Order uint8
+
+// The orders of the members of a cooperation.
+const (
+	OrderListed Order = iota // OrderListed keeps the members in the order of the URL path.
+	OrderSorted              // OrderSorted sorts the members by their [SortKey], so the same cooperation always has the same key.
+)
+
+// IndexKey returns the index key of the URL path to be stored in a database table.
+// The URL path is resolved in the same way as [Humanize], so the key is the uppercased
+// humanized name, including the special names such as "TDT / TRSI" for "coop".
+// The key only uses plain ASCII letters, digits and spaces, and the separators of the
+// cooperation members, so the diacritics are folded and the other symbols are removed.
+// The legacy key of the earlier releases is kept when a symbol is used in place of a letter,
+// such as "Spec┼raL", or when the humanized name only respaces or shortens the legacy key,
+// such as "2000AD" for "2000 AD".
+// The order arranges the members of a cooperation, but special names and
+// known releasers are never reordered.
+// If the URL path contains invalid characters then an empty string is returned.
+//
+// Example:
+//
+//	IndexKey("coop", OrderListed) = "TDT / TRSI"
+//	IndexKey("image-nj", OrderListed) = "IMAGE NJ"
+//	IndexKey("2000ad", OrderListed) = "2000 AD"
+//	IndexKey("trsi*razor-1911-demo", OrderListed) = "TRSI, RAZOR 1911 DEMO"
+//	IndexKey("trsi*razor-1911-demo", OrderSorted) = "RAZOR 1911 DEMO, TRSI"
+func IndexKey(path string, order Order) string {
+	p := resolve(path)
+	if paths[p] || !strings.Contains(string(p), "*") {
+		return indexKey(p)
+	}
+	members := strings.Split(string(p), "*")
+	if order == OrderSorted {
+		slices.SortStableFunc(members, func(a, b string) int {
+			return cmp.Or(cmp.Compare(SortKey(Humanize(a)), SortKey(Humanize(b))), cmp.Compare(a, b))
+		})
+	}
+	keys := make([]string, 0, len(members))
+	for _, member := range members {
+		key := indexKey(resolve(member))
+		if key == "" {
+			return ""
+		}
+		keys = append(keys, key)
+	}
+	return strings.Join(keys, spacedComma)
+}
+
+// indexKey returns the index key of a releaser that is not a cooperation of other releasers.
+// The members of a special name, such as "TDT / TRSi", are keyed individually and keep their separators.
+func indexKey(path name.Path) string {
+	h := Humanize(string(path))
+	if h == "" {
+		return ""
+	}
+	segments := segments(h)
+	const sep = 2
+	if len(segments) == 1 {
+		return plainKey(h, legacyIndex(path))
+	}
+	var b strings.Builder
+	for i, segment := range segments {
+		if i%sep == 1 {
+			b.WriteString(segment)
+			continue
+		}
+		b.WriteString(plainKey(segment, legacyIndex(obfuscate(segment))))
+	}
+	return b.String()
+}
+
+// segments returns the members of the humanized name interleaved with their separators.
+func segments(s string) []string {
+	list := []string{}
+	start := 0
+	for i := 0; i < len(s); i++ {
+		for _, sep := range memberSeparators {
+			if sep == "*" || !strings.HasPrefix(s[i:], sep) {
+				continue
+			}
+			list = append(list, s[start:i], sep)
+			i += len(sep) - 1
+			start = i + 1
+			break
+		}
+	}
+	return append(list, s[start:])
+}
+
+// plainKey returns the humanized name as uppercased plain ASCII letters, digits and spaces,
+// or the legacy key if the name uses a symbol in place of a letter, or if the plain key
+// only respaces or shortens the legacy key.
+func plainKey(s, legacy string) string {
+	var b strings.Builder
+	for _, r := range strings.ToUpper(fold(s)) {
+		switch {
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r == ' ', r == '-', r == '/':
+			b.WriteRune(' ')
+		case r > unicode.MaxASCII:
+			return legacy
+		}
+	}
+	key := strings.Join(strings.Fields(b.String()), " ")
+	if key == "" || (key != legacy && strings.HasPrefix(squash(legacy), squash(key))) {
+		return legacy
+	}
+	return key
+}
+
+// squash returns only the letters and digits of the key.
+func squash(key string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, key)
+}
+
+// An IndexChange is a known URL path with an index key that differs from its legacy key.
+type IndexChange struct {
+	Path name.Path // Path is the URL path of the known releaser.
+	Old  string    // Old is the legacy index key, which skipped the special names and resolutions of Humanize.
+	New  string    // New is the index key returned by [IndexKey].
+}
+
+// IndexChanges returns every known URL path with an index key that would change from the
+// legacy key to the key returned by [IndexKey] using the order, sorted by the URL path.
+// The changes can be used to migrate the index keys that are stored in a database table.
+// Only the URL paths of the special names and initialisms are known, so the keys of other
+// releasers and cooperations are not reported and should be compared individually,
+// such as with the Index form of the migrate package.
+//
+// Example:
+//
+//	IndexChanges(OrderListed) = []IndexChange{..., {Path: "coop", Old: "COOP", New: "TDT / TRSI"}, ...}
+func IndexChanges(order Order) []IndexChange {
+	changes := []IndexChange{}
+	for _, path := range slices.Sorted(maps.Keys(paths)) {
+		old := legacyIndex(path)
+		if key := IndexKey(string(path), order); key != old {
+			changes = append(changes, IndexChange{Path: path, Old: old, New: key})
+		}
+	}
+	return changes
+}
+
+// legacyIndex returns the index key of the URL path using the rules of the earlier
+// releases, which uppercased the humanized path without resolving the special names.
+func legacyIndex(path name.Path) string {
+	s, err := name.Humanize(name.Path(strings.ToLower(string(path))))
+	if err != nil {
+		return ""
+	}
+	return strings.ToUpper(s)
+}
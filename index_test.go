@@ -0,0 +1,112 @@
+package releaser_test
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Defacto2/releaser"
+	"github.com/nalgeon/be"
+)
+
+func ExampleIndexKey() {
+	fmt.Println(releaser.IndexKey("trsi*razor-1911-demo", releaser.OrderListed))
+	fmt.Println(releaser.IndexKey("trsi*razor-1911-demo", releaser.OrderSorted))
+	// Output: TRSI, RAZOR 1911 DEMO
+	// RAZOR 1911 DEMO, TRSI
+}
+
+func TestIndexKey(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		path  string
+		order releaser.Order
+		want  string
+	}{
+		{"", releaser.OrderListed, ""},
+		{"razor-1911-demo#trsi", releaser.OrderListed, ""},
+		{"coop", releaser.OrderListed, "TDT / TRSI"},
+		{"coop", releaser.OrderSorted, "TDT / TRSI"},
+		{"razor1911", releaser.OrderListed, "RAZOR 1911"},
+		{"image-nj", releaser.OrderListed, "IMAGE NJ"},
+		{"image-productions-2", releaser.OrderListed, "IMAGE PRODUCTIONS 2"},
+		{"2000ad*2000-ad", releaser.OrderListed, "2000AD, 2000 AD"},
+		{"red-dot-bbs", releaser.OrderListed, "RED DOT BBS"},
+		{"class*paradigm*razor-1911", releaser.OrderListed, "CLASS, PARADIGM, RAZOR 1911"},
+		{"razor-1911*paradigm*class", releaser.OrderSorted, "CLASS, PARADIGM, RAZOR 1911"},
+		{"the-dream-team*fairlight", releaser.OrderSorted, "THE DREAM TEAM, FAIRLIGHT"}, // SortKey ignores "The"
+		{
+			"united-software-association*fairlight", releaser.OrderSorted,
+			"UNITED SOFTWARE ASSOCIATION + FAIRLIGHT PC DIVISION",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			t.Parallel()
+			be.Equal(t, releaser.IndexKey(tt.path, tt.order), tt.want)
+		})
+	}
+}
+
+func TestIndexHumanize(t *testing.T) {
+	t.Parallel()
+	// Index and Humanize share the same resolution of the URL path,
+	// but the index keys only use plain ASCII and keep the legacy form of a stylized name
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"coop", "TDT / TRSI"},
+		{"razor1911", "RAZOR 1911"},
+		{"the-dream-team-2", "THE DREAM TEAM 2"},
+		{"devils-realm-bbs", "DEVILS REALM BBS"},
+		{"scene.org", "SCENE.ORG"},
+		{"red-dot-bbs", "RED DOT BBS"},
+		{"scorpion", "SCORPION"},
+		{"spectral", "SPECTRAL"},
+		{"excel_xl", "EXCEL-XL"},
+		{"tdu_jam", "TDU-JAM"},
+		{"2000-ad", "2000 AD"},
+		{"excretion-anarchy", "EXCRETION ANARCHY"},
+		{"mobius", "MOBIUS"},
+		{"unknown-couriers", "THE UNKNOWN COURIERS"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			t.Parallel()
+			be.True(t, releaser.Humanize(tt.path) != "")
+			be.Equal(t, releaser.Index(tt.path), tt.want)
+			be.Equal(t, releaser.Index(tt.path), releaser.IndexKey(tt.path, releaser.OrderListed))
+		})
+	}
+}
+
+func TestIndexPlain(t *testing.T) {
+	t.Parallel()
+	for _, c := range releaser.IndexChanges(releaser.OrderListed) {
+		if strings.IndexFunc(c.New, func(r rune) bool {
+			return !strings.ContainsRune("ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789 &,-./+", r)
+		}) >= 0 {
+			t.Errorf("%s: %q is not a plain index key", c.Path, c.New)
+		}
+	}
+}
+
+func TestIndexChanges(t *testing.T) {
+	t.Parallel()
+	changes := releaser.IndexChanges(releaser.OrderListed)
+	be.True(t, len(changes) > 0)
+	be.True(t, slices.IsSortedFunc(changes, func(a, b releaser.IndexChange) int {
+		return cmp.Compare(a.Path, b.Path)
+	}))
+	i := slices.IndexFunc(changes, func(c releaser.IndexChange) bool { return c.Path == "coop" })
+	be.True(t, i >= 0)
+	be.Equal(t, changes[i], releaser.IndexChange{Path: "coop", Old: "COOP", New: "TDT / TRSI"})
+	for _, c := range changes {
+		be.True(t, c.Old != c.New)
+		be.Equal(t, c.New, releaser.IndexKey(string(c.Path), releaser.OrderListed))
+	}
+	be.True(t, !slices.ContainsFunc(changes, func(c releaser.IndexChange) bool { return c.Path == "razor-1911" }))
+}
@@ -8,9 +8,9 @@
 package initialism
 
 import (
-	"maps"
 	"slices"
 	"strings"
+	"sync"
 )
 
 // A Path is the partial URL path of the releaser.
@@ -19,6 +19,27 @@ type Path string
 // List is a map of initialisms to releasers.
 type List map[Path][]string
 
+// alias returns the value as an alternative spelling.
+func alias(value string) Spelling {
+	return Spelling{Value: value, Type: TypeAlias}
+}
+
+// acronym returns the value as an acronym that is pronounced as a word.
+func acronym(value string) Spelling {
+	return Spelling{Value: value, Type: TypeAcronym}
+}
+
+// initial returns the value as an initialism that is spelled out letter by letter.
+func initial(value string) Spelling {
+	return Spelling{Value: value, Type: TypeInitialism}
+}
+
+// note returns the spelling with the curator notes.
+func (s Spelling) note(notes string) Spelling {
+	s.Notes = notes
+	return s
+}
+
 // path keys that are too long for the initialism map.
 const (
 	cpc2001 = "corporation-for-public-cybercasting-2001"
@@ -80,1377 +101,1417 @@ const (
 //   - In Go, the order of the List map keys is randomized and has no performance impact.
 //
 // [releaser/name]: https://github.com/Defacto2/releaser/name
-func Initialisms() *List { //nolint:maintidx
-	list := List{
-		"nukers-database":                       {"nDB"},
-		"software-distribution-corporation":     {"SDC"},
-		"sons-of-boredom":                       {"SOB"},
-		"lousy-old-loops":                       {"lool"},
-		"doomsday-machines":                     {"DDM"},
-		"devious-dezigns":                       {"DVS"},
-		"faction-amiga":                         {"FTN"},
-		"highlight":                             {"HL"},
-		"highlight-ampersand-resistance-inc":    {"HLRI"},
-		"hotstuffers":                           {"HTS"},
-		"necropedophillic-anti_social-imbecils": {"NAI"},
-		"avantgarde":                            {"AVT"},
-		"stealth-force":                         {"TSF"},
-		"rpm-bbs":                               {"Revolutions Per Minute BBS", "R.P.M BBS"},
-		"swat":                                  {"Special Warez Acquisition Team", "S.W.A.T"},
-		"cloud-nine-elite-bbs":                  {"Cloud 9 Elite BBS"},
-		"phunline-bbs":                          {"The Phun Line BBS"},
-		"nevada-testing-grounds-bbs":            {"NTG BBS"},
-		"paladium-bbs":                          {"The Paladium BBS"},
-		"devils-realm-bbs":                      {"The Devil's Realm BBS"},
-		"penthouse-bbs":                         {"The Penthouse BBS"},
-		"wild-side-bbs":                         {"The Wild Side"},
-		"four-past-midnight-bbs":                {"4PM BBS", "FPM BBS"},
-		"banished-corrosive-poison-bbs":         {"BCP BBS", "BNP BBS"},
-		"lost-souls-domain-ii-bbs":              {"Lost Souls Domain 2 BBS", "LSD2 BBS", "LSDII BBS"},
-		"marauders-hideout-bbs":                 {"THM BBS", "The Marauder's Hideout BBS"},
-		"brotherhood-of-thieves-bbs":            {"BOT BBS"},
-		"harmony-skates-bbs":                    {"SK8 BBS", "Harmony SK8 BBS"},
-		"icepack":                               {"iCE PACK", "iCE Trial", "ITR", "ICEPK"},
-		"art-creation-enterprise":               {ace, "ACE Productions", "A.C.E."},
-		"underground-oasis-bbs":                 {"TUGO", "The Underground Oasis BBS"},
-		"skill":                                 {"SKiLL/iCE Trial", "SKL", "BARQ"},
+func Initialisms() *List {
+	list := make(List, len(dictionary()))
+	for path, spellings := range dictionary() {
+		values := make([]string, 0, len(spellings))
+		for _, s := range spellings {
+			values = append(values, s.Value)
+		}
+		list[path] = values
+	}
+	return &list
+}
+
+// dictionary is the cached list of spellings, so the map is only built once.
+var dictionary = sync.OnceValue(spellings) //nolint:gochecknoglobals
+
+// spellings returns the alternative spellings, acronyms and initialisms of the releasers.
+// Every spelling records its [Type] and any optional curator notes,
+// using the alias, acronym and initial helpers for the spellings without notes.
+// The same rules as [Initialisms] apply.
+func spellings() map[Path][]Spelling { //nolint:maintidx
+	list := map[Path][]Spelling{
+		"nukers-database":                       {initial("nDB")},
+		"software-distribution-corporation":     {initial("SDC")},
+		"sons-of-boredom":                       {initial("SOB")},
+		"lousy-old-loops":                       {initial("lool")},
+		"doomsday-machines":                     {initial("DDM")},
+		"devious-dezigns":                       {initial("DVS")},
+		"faction-amiga":                         {initial("FTN")},
+		"highlight":                             {initial("HL")},
+		"highlight-ampersand-resistance-inc":    {initial("HLRI")},
+		"hotstuffers":                           {initial("HTS")},
+		"necropedophilic-anti_social-imbeciles": {initial("NAI")},
+		"avantgarde":                            {initial("AVT")},
+		"stealth-force":                         {initial("TSF")},
+		"rpm-bbs":                               {alias("Revolutions Per Minute BBS"), alias("R.P.M BBS")},
+		"swat":                                  {alias("Special Warez Acquisition Team"), alias("S.W.A.T")},
+		"cloud-nine-elite-bbs":                  {alias("Cloud 9 Elite BBS")},
+		"phunline-bbs":                          {alias("The Phun Line BBS")},
+		"nevada-testing-grounds-bbs":            {initial("NTG BBS")},
+		"paladium-bbs":                          {alias("The Paladium BBS")},
+		"devils-realm-bbs":                      {alias("The Devil's Realm BBS")},
+		"penthouse-bbs":                         {alias("The Penthouse BBS")},
+		"wild-side-bbs":                         {alias("The Wild Side")},
+		"four-past-midnight-bbs":                {initial("4PM BBS"), initial("FPM BBS")},
+		"banished-corrosive-poison-bbs":         {initial("BCP BBS"), initial("BNP BBS")},
+		"lost-souls-domain-ii-bbs": {
+			alias("Lost Souls Domain 2 BBS"), initial("LSD2 BBS"), initial("LSDII BBS"),
+		},
+		"marauders-hideout-bbs":      {initial("THM BBS"), alias("The Marauder's Hideout BBS")},
+		"brotherhood-of-thieves-bbs": {initial("BOT BBS")},
+		"harmony-skates-bbs":         {initial("SK8 BBS"), alias("Harmony SK8 BBS")},
+		"icepack":                    {alias("iCE PACK"), alias("iCE Trial"), initial("ITR"), alias("ICEPK")},
+		"art-creation-enterprise":    {acronym(ace), alias("ACE Productions"), initial("A.C.E.")},
+		"underground-oasis-bbs":      {acronym("TUGO"), alias("The Underground Oasis BBS")},
+		"skill":                      {alias("SKiLL/iCE Trial"), initial("SKL"), alias("BARQ")},
 		"insane-creators-enterprise": {
-			"iCE", "iCE Advertisements", "The New Order",
-			"TNO", "iCE/TNO", "iCE Trial",
+			initial("iCE"), alias("iCE Advertisements"), alias("The New Order"),
+			initial("TNO"), alias("iCE/TNO"), alias("iCE Trial"),
+		},
+		"insanity-corporate-network": {initial("iCN"), alias("iNSANITY")},
+		"peoples-front-of-judea-bbs": {alias("The Peoples Front of Judea BBS"), initial("The PFJ BBS")},
+		"programmers-inn-bbs":        {alias("Programmer's Inn BBS")},
+		"coliseum-bbs":               {alias("Colisevm BBS")},
+		"unknown-bbs":                {alias("The Unknown BBS")},
+		"final-frontier-bbs":         {alias("The Final Frontier BBS"), initial("TFF")},
+		"warzone-bbs":                {alias("The Warzone BBS"), alias("The War Zone BBS")},
+		"franks-palace-bbs":          {alias("Frank's BBS"), alias("Frank's Palace BBS")},
+		"dragons-hold-bbs":           {alias("The Dragon's Hold BBS")},
+		"ghostship-bbs":              {alias("The Ghostship BBS")},
+		"deliberate-meltdown-bbs":    {alias("PMA WHQ BBS")},
+		"euphoria-bbs":               {initial("EU4iA")},
+		"silo-bbs":                   {alias("The Silo BBS")},
+		"midnight-oil-bbs": {
+			alias("Mid-Nite-Oil BBS"), alias("The Mid Nite Oil BBS"), alias("Mid-Nite Oil BBS"),
+		},
+		"hamburger-heaven-bbs":                   {alias("Hamburger Heaven BBS")},
+		"frayed-ends-of-sanity-bbs":              {alias("TFEoS"), alias("The Frayed Ends Of Sanity BBS")},
+		"source-bbs":                             {alias("The Source BBS")},
+		"maniac-bbs":                             {alias("The Maniac BBS")},
+		"citadel-bbs":                            {alias("The Citadel BBS")},
+		"untouchables-bbs":                       {alias("The Untouchables BBS")},
+		"undiscovered-bbs":                       {alias("The Undiscovered BBS")},
+		"xtc-systems-bbs":                        {alias("X-T-C System BBS"), alias("XTC")},
+		"ace-bbs":                                {alias("A.C.E BBS")},
+		"abyss-bbs":                              {alias("The Abyss BBS")},
+		"ravers-zone-bbs":                        {alias("The Raver's Zone BBS")},
+		"hms-bounty-bbs":                         {alias("H.M.S. Bounty BBS")},
+		"demons-forge-ca-bbs":                    {alias("Demon's Forge CA BBS")},
+		"digital-underground-bbs":                {alias("The Digital Underground BBS"), initial("TDU BBS")},
+		"state-of-devolution-bbs":                {initial("Devoltion BBS")},
+		"wall-bbs":                               {alias("The Wall BBS")},
+		"citadel-of-chaos-bbs":                   {initial("COC BBS")},
+		"cyberdyne-systems-bbs":                  {initial("CDS BBS")},
+		"fatal-future-bbs":                       {initial("FF BBS")},
+		"candyland-bbs":                          {alias("Candy Land BBS")},
+		"circle_city-bbs":                        {alias("The Circle-City BBS")},
+		"warp-speed-bbs":                         {alias("Warp Speed I BBS"), alias("Warp Speed II BBS")},
+		"celestial-woodlands-bbs":                {alias("The Celestial Woodlands BBS"), alias("C. Woodlands BBS")},
+		"bog-bbs":                                {alias("The Bog BBS")},
+		"ethereal-dimension-bbs":                 {alias("The Ethereal Dimension BBS")},
+		"rogues-gallery-bbs":                     {alias("Rogues' Gallery Bulletin Board System")},
+		"warehouse-bbs":                          {alias("The Warehouse BBS"), initial("TWH BBS")},
+		"neutral-zone-bbs":                       {alias("The Neutral Zone BBS")},
+		"viper-pit-bbs":                          {alias("The Viper Pit BBS")},
+		"festering-pit-of-vile-excretions-bbs":   {alias("The Festering Pit BBS")},
+		"boners-domain-bbs":                      {alias("The Boner's Domain BBS")},
+		"circuits-edge-bbs":                      {alias("The Circuits Edge BBS")},
+		"darkside-bbs":                           {alias("The Darkside BBS")},
+		"pirate-bbs":                             {alias("The Pirate BBS")},
+		"warez-houze-bbs":                        {alias("The Warez Houze BBS"), alias("WaREZ HouZE Super System BBS")},
+		"support-hq-bbs":                         {alias("The Support HQ BBS"), initial("SHQ BBS")},
+		"solar-system-bbs":                       {alias("Solar BBS")},
+		"sanitarium-bbs":                         {alias("The Sanitarium BBS")},
+		"rush-bbs":                               {alias("The Rush BBS")},
+		"rock-creek-bbs":                         {alias("The RockCreek BBS")},
+		"private-collection-bbs":                 {alias("The Private Collection BBS"), initial("TPC BBS")},
+		"notice-bbs":                             {alias("The Notice BBS")},
+		"next-dimension-bbs":                     {initial("TND BBS"), alias("The Next Dimension BBS")},
+		"menace-ii-society-bbs":                  {initial("M2S BBS"), alias("Menace 2 Society BBS")},
+		"atlanta-pcug-bbs":                       {alias("Atlanta IBM-PCUG BBS"), alias("Atlanta IBM PC User Group")},
+		"board-bbs":                              {alias("The Board BBS")},
+		"cauldron-bbs":                           {alias("The Cauldron BBS")},
+		"apocalypse-bbs":                         {alias("The Apocalypse BBS")},
+		"spyrits-crypt-bbs":                      {alias("Spyrit's Crypt BBS")},
+		"slave-den-bbs":                          {alias("The Slave Den BBS")},
+		"software-gallery-bbs":                   {initial("TSG BBS"), alias("The Software Gallery BBS")},
+		"razors-edge-bbs":                        {alias("The Razor's Edge BBS")},
+		"carrier-of-belief-bbs":                  {initial("COB BBS")},
+		"red-or-dead-bbs":                        {initial("ROD BBS")},
+		"outer-limits-bbs":                       {alias("The Outer Limits BBS")},
+		"northern-palace-bbs":                    {alias("The Northern Palace BBS")},
+		"killer-town-bbs":                        {alias("Killertown BBS")},
+		"junction-bbs":                           {alias("The Junction BBS")},
+		"hell-hole-bbs":                          {alias("HellHole BBS")},
+		"elusive-dreams-bbs":                     {alias("The Elusive Dream BBS")},
+		"deadline-bbs":                           {alias("The Deadline BBS")},
+		"dark-society-bbs":                       {initial("TDS BBS"), alias("The Dark Society BBS")},
+		"cloak-n-dagger-bbs":                     {alias("Cloak & Dagger BBS"), alias("Cloak -N- Dagger BBS")},
+		"crusades-bbs":                           {alias("The Crusades BBS")},
+		"maximum-rocknroll-bbs":                  {alias("Maximum Rock'n Roll BBS"), alias("Maximum Rock Roll BBS")},
+		"marines-bbs":                            {alias("The Marines BBS"), initial("TMB")},
+		"chillout-zone-bbs":                      {alias("The Chillout Zone BBS")},
+		"agents-of-fortune-bbs":                  {initial("AOF BBS"), initial("ÆOF BBS")},
+		"manhattan-project-bbs":                  {alias("The Manhattan Project BBS")},
+		"last-resort-bbs":                        {alias("The Last Resort BBS")},
+		"fate-gate-bbs":                          {alias("Fategate BBS"), initial("FG BBS")},
+		"dark-realm-bbs":                         {alias("The Dark Realm BBS"), initial("TDR BBS")},
+		"pleasure-dome-bbs":                      {alias("The Pleasure Dome BBS")},
+		"pentagon-bbs":                           {alias("The Pentagon BBS"), initial("PTG BBS")},
+		"freeside-bbs":                           {alias("Fastjack's Freeside BBS"), initial("FS BBS")},
+		"beyond-akira-bbs":                       {alias("Akira BBS"), alias("Beyond BBS")},
+		"park-central-bbs":                       {initial("PC BBS")},
+		"digital-fringe-bbs":                     {alias("The Digital Fringe BBS")},
+		"underworld-bbs":                         {alias("The Underworld BBS"), initial("TUW BBS")},
+		"asylum-bbs":                             {alias("The Asylum BBS"), alias("ASYL BBS")},
+		"sanctuary-bbs":                          {alias("The Sanctuary BBS"), alias("Sanct BBS"), alias("SANC BBS")},
+		"retaliators-place-bbs":                  {initial("RPB"), alias("Retaliator's Place BBS")},
+		"void-bbs":                               {alias("The Void BBS")},
+		"rusty-n-edies-bbs":                      {alias("Rusty n Edie's BBS")},
+		"arcade-bbs":                             {alias("The Arcade BBS")},
+		"hood-bbs":                               {alias("The Hood BBS")},
+		"house-of-pain-bbs":                      {initial("HOP BBS"), initial("THOP BBS")},
+		"infinite-darkness-bbs":                  {initial("ID BBS")},
+		"mirage-bbs":                             {alias("The Mirage BBS")},
+		"insane-asylum-bbs":                      {alias("The Insane Asylum BBS"), initial("TIA BBS")},
+		"black-hole-bbs":                         {alias("The Black Hole BBS")},
+		"badlands-bbs":                           {alias("The Badlands BBS")},
+		"tower-of-sorcery-bbs":                   {initial("TOS BBS")},
+		"tribe-bbs":                              {alias("The Tribe BBS")},
+		"yard-bbs":                               {alias("The Yard BBS"), initial("TY")},
+		"pits-bbs":                               {alias("The Pits BBS"), alias("The P.I.T.S. BBS")},
+		"world-of-elite-bbs":                     {initial("WOE")},
+		"international-software-alliance":        {initial("ISA")},
+		"dark-side-alliance":                     {initial("DSA")},
+		"angels-on-drugs":                        {initial("AOD")},
+		"endreamz":                               {initial("EDM")},
+		"wad":                                    {alias("Wad Crew"), alias("WADiSO")},
+		"sos-iso":                                {alias("SoSiSO"), alias("SoSFXP"), alias("SoSFXP ISO")},
+		"icoiso":                                 {alias("Independent Couriers Of iSO")},
+		"sharper-image":                          {initial("SI")},
+		"warez-without-limits":                   {initial("WWL")},
+		"playmagic":                              {initial("PLY")},
+		"free-trade-fxp":                         {initial("FTFiSO"), initial("FTF")},
+		"parents-on-puterz":                      {initial("POP")},
+		"only-the-finest-warez":                  {initial("OTFW")},
+		"drift":                                  {alias("Drift'ers"), alias("DRiFTers")},
+		"slow-trading-droopz":                    {initial("STD")},
+		"wasteland-ftp":                          {initial("TWL"), alias("The Wasteland FTP")},
+		"rock-ftp":                               {alias("The Rock FTP")},
+		"v_i_b_e_s":                              {initial("VBS"), alias("VIBES")},
+		"intel":                                  {alias("International Nocturnal Team of Elite Loaders")},
+		"compress-da-audio":                      {initial("CDA"), alias("Compress 'da Audio")},
+		"bleachbox-ftp":                          {initial("BBX")},
+		"numbers":                                {alias("The Numbers"), alias("*NuMbErS*"), alias("NUM")},
+		"the-warez-loop":                         {alias("The Warez Report"), alias("MindBenders Report")},
+		"zczi":                                   {initial("SCSI"), alias("sc2i")},
+		"msftug":                                 {alias("More shit from the underground")},
+		"mortality":                              {initial("MTY")},
+		"internet-relay-network":                 {initial("IRN")},
+		"inner-circle":                           {initial("IC")},
+		"generation-x":                           {alias("gen-x"), initial("GNX")},
+		"eclipse-interactive":                    {initial("EPI")},
+		"darkside-couriers":                      {initial("DSC")},
+		"assimilation":                           {initial("ASM")},
+		"cellblock-4":                            {initial("CB4")},
+		"majik":                                  {initial("MJK")},
+		"masters-of-destruction":                 {initial("MOD")},
+		"fuck-off-or-die":                        {initial("FOOD")},
+		"digital-corruption":                     {initial("DC")},
+		"crc":                                    {alias("CoRRuPTiON")},
+		"swift-couriering-inc":                   {initial("SCI")},
+		"ians-rotting-corpse":                    {initial("IRC")},
+		"lords-of-chaos":                         {initial("LoC")},
+		"malfunction-system-group":               {initial("MfSG")},
+		"elite-underground":                      {initial("EU")},
+		"anarchy-and-armageddon-network":         {initial("AAA")},
+		"andromeda-software-development":         {initial("ASD")},
+		"black-widow":                            {initial("BLW")},
+		"united-artists-association":             {initial("UAA")},
+		"italian-cracking-service":               {initial("ICS")},
+		"ddd":                                    {alias("D.D.D."), alias("Dr. Death / Darkhawk")},
+		"echo-mirage":                            {initial("EM")},
+		"elite-programmers-association":          {initial("EPA")},
+		"casa":                                   {alias("California Sysop Association")},
+		"union-of-crackers":                      {initial("UoC")},
+		"game_busters":                           {alias("BlackMax's Gamebusters Inc.")},
+		"bad-association":                        {alias("BBS's Against Dweebs")},
+		"west-coast-alliance":                    {initial("WCA")},
+		"warriors-against-copy-protection":       {acronym("WACP")},
+		"the-alternative":                        {alias("An Alternative release"), alias("Celerity")},
+		"state-of-the-art":                       {acronym("SOTA")},
+		"boogie-down-productions":                {initial("BDP")},
+		"digital-exchange-pirate-board-alliance": {acronym("DEPBA")},
+		"the-knights-of-the-round-table":         {initial("TKRT")},
+		"sprint":                                 {alias("$PRINT")},
+		"black-star-productions":                 {initial("B*P")},
+		"the-billionarre-boys-club":              {initial("BBC"), alias("Billionaire Boys Club")},
+		"c-ampersand-m":                          {initial("C&M")},
+		"united-file-traderz":                    {initial("UFT")},
+		"european-trading-alliance":              {initial("ETA")},
+		"best-of-the-best-phreaking-man":         {initial("BOB")},
+		"hungarian-megacracker-group":            {initial("HMG")},
+		"inter-active":                           {initial("IA")},
+		"unreal-reality":                         {initial("UR")},
+		"success-pc":                             {initial("SPC")},
+		"the-primal-order":                       {initial("TPO")},
+		"the-avocado-avengers":                   {initial("TAA")},
+		"madras":                                 {alias(mad)},
+		"mirth":                                  {initial("MTH")},
+		"bs-enterprize":                          {alias("B.S. Enterprize")}, //nolint:misspell
+		"osiris":                                 {initial("ORS")},
+		"dark-force":                             {initial("DF")},
+		"calculus":                               {initial("TCG"), alias("The Calculus Group")},
+		"distorted":                              {initial("DSD")},
+		"cyanide":                                {initial("CYN")},
+		"german-diskdoubler":                     {initial("GDD")},
+		"un_touchable-force-organization":        {acronym("UTFO")},
+		"jackshit":                               {initial("jS")},
+		"187":                                    {alias("187 Couriers"), alias("One Eight Seven")},
+		"banch-o-guyz":                           {initial("BOG")},
+		"lamer-of-the-world":                     {acronym("LOTW")},
+		"hasp":                                   {alias("H.A.S.P.")},
+		"roi-production":                         {alias("ROI"), alias("WaREZ ROI")},
+		"indigo":                                 {initial("IGD")},
+		"storm-inc":                              {initial("SM")},
+		"syndrome":                               {initial("SYD")},
+		"the-cracking-team":                      {initial("TCT")},
+		uart:                                     {initial("UART"), initial("URT")},
+		"universal-crime-league":                 {initial("UCL")},
+		"phase-one":                              {initial("P1")},
+		"the-damaged-inc":                        {initial("TDi")},
+		"undercover-agents":                      {initial("UA")},
+		"atomic-review":                          {initial("ATM"), alias("Atomic Review Krew")},
+		"ironside-data-productions":              {initial("iDP")},
+		"italian-crackware-inc":                  {initial("ICI")},
+		"scd_dox":                                {alias("SCD"), alias("Software Chronicles Digest / Dox Division")},
+		"needful-things":                         {initial("NT")},
+		"one-man-courier":                        {initial("OMC")},
+		"no-bullshit-couriering":                 {initial("NBC")},
+		"north-american-release-coalition":       {acronym("NARC")},
+		"dual-module-player":                     {initial("DMP")},
+		"global-overdose":                        {initial("GOD")},
+		"new-order":                              {initial("NO")},
+		"butthole-surfers":                       {initial("BH")},
+		"lightning-force":                        {initial("LF")},
+		"psychosquad":                            {initial("PSD")},
+		"rage-against-the-machine":               {initial("RAM")},
+		"wankers-from-wimbledon":                 {initial("WW")},
+		"mental-design":                          {initial("MD")},
+		"jammin-the-airwaves":                    {initial("JTA")},
+		"never-at-rest-couriers":                 {acronym("NARC")},
+		"siac":                                   {alias("SiÆC")},
+		"syndicated-network-of-couriers":         {acronym("SyNC")},
+		"sliver-art-products":                    {initial("SAP")},
+		"virtual-shock":                          {initial("VS")},
+		"5th-dynasty":                            {initial("5D")},
+		"paradigm-press":                         {initial("prdgm")},
+		"corosion":                               {alias("COR")}, //nolint:misspell
+		"warriors-against-software-protection":   {acronym("WASP")},
+		"no-lamerz-allowed":                      {initial("NLA")},
+		"anoxia":                                 {initial("ANX")},
+		"north-american-pirates":                 {initial("NAP")},
+		"avengers":                               {initial("AVG")},
+		"computer-pirate-syndicate":              {initial("CPS"), initial("CP$")},
+		"visual-simulations-inc":                 {initial("VSI")},
+		"the-golden-triangle":                    {initial("TGT")},
+		"the-newcomers":                          {initial("TNC")},
+		"china-syndrome-inc":                     {initial("CSI")},
+		"housetek":                               {initial("HTK")},
+		"outlaws-exchange":                       {initial("OX")},
+		"syndromes-mega-utility-team":            {acronym("SMUT")},
+		"entity":                                 {alias("ntt")},
+		"ntt":                                    {alias("NTT")},
+		"quick-silver":                           {initial("QSR"), alias("QuickSilver")},
+		"pyrodex":                                {initial("PRX"), alias("Pyrodex PC Division")},
+		"psychedelic-excretion-international":    {initial("PEi"), alias("Psychdelic Excretion International")},
+		"xtreemer":                               {initial("XT")},
+		"top-curry-group":                        {initial("TCG")},
+		"just-the-facts-handheld-edition":        {initial("JTF")},
+		"weekly-wanking-stats":                   {initial("WWS")},
+		"pocketheaven":                           {initial("PH")},
+		"just-week-stats":                        {initial("JWS")},
+		"some-weekly-chart":                      {initial("SWC")},
+		"who-owned-weekly":                       {initial("WoW")},
+		"the-marshall-mussolini-show":            {initial("TMMS")},
+		"weekly-courier-stats-report":            {initial("WCSR")},
+		"the-tobacco-brothers":                   {initial("TTBC")},
+		"orgasming-gaming-magazine":              {initial("OGM"), alias("ORGAS")},
+		"monthly-cracking-report":                {initial("MCR")},
+		"zero-second-report":                     {initial("0SR")},
+		"unbiased-courier-report":                {initial("UCR")},
+		"the-big-picture-courier-report":         {initial("TBP")},
+		"prozacs-personal-gaming-report":         {initial("PPGR")},
+		"gameboycolor-world-charts":              {initial("GBWC"), alias("GBC World")},
+		"dextrose-chart":                         {initial("DX")},
+		"couriers-weekly":                        {initial("CW")},
+		"corrupt-console-diskmag":                {alias("CORR")},
+		"actual-factual-couring":                 {initial("AfC")},
+		"frontline-scene-release-report":         {initial("FTL")},
+		"future-scene-news":                      {initial("FSN")},
+		"nuclear-crackers":                       {initial("NC")},
+		nwo:                                      {initial("NWO")},
+		"scene-top-traders":                      {initial("STT")},
+		"weekly-courier-report":                  {initial("WCR")},
+		"top-telnet-traders-weekly":              {initial("TTW")},
+		"the-legendary-report":                   {initial("TLR")},
+		"resistance":                             {initial("RSE")},
+		mcsci:                                    {initial("MCSCI")},
+		"zenith-zine":                            {initial("ZZ")},
+		"canadian-born-coders":                   {initial("CBC")},
+		"virus-laboratories-and-distribution":    {initial("VLAD")},
+		"higher-mental-plane":                    {initial("hmp")},
+		"dutch-trader-charts":                    {initial(dtc)},
+		"bbs-and-users-digest":                   {initial("BAUD")},
+		"trip-2-hell":                            {initial("T2h")},
+		"strictly-pirates":                       {initial("SP"), initial("SP!")},
+		"software-runners-from-hell":             {initial("SRH")},
+		"software-pirates-alliance":              {initial("SPA")},
+		"rebels-of-telecommunications":           {initial("ROT")},
+		"quality-control-reviews":                {initial("QC")},
+		"evolution-magazine":                     {alias("EVO"), alias("EVOL")},
+		"digital-press":                          {initial("DP")},
+		"bizarre-types-of-wares":                 {initial("BTW")},
+		"underground-experts-united":             {initial("UXU")},
+		"the-review-crew":                        {initial("TRC")},
+		"poison-control":                         {initial("PCi")},
+		"magick": {
+			initial("MGK"), acronym("MUDD"), initial("M.U.D.D."),
+			alias("Magick Utilities & Demo's Division"),
 		},
-		"insanity-corporate-network":             {"iCN", "iNSANITY"},
-		"peoples-front-of-judea-bbs":             {"The Peoples Front of Judea BBS", "The PFJ BBS"},
-		"programmers-inn-bbs":                    {"Programmer's Inn BBS"},
-		"coliseum-bbs":                           {"Colisevm BBS"},
-		"unknown-bbs":                            {"The Unknown BBS"},
-		"final-frontier-bbs":                     {"The Final Frontier BBS", "TFF"},
-		"warzone-bbs":                            {"The Warzone BBS", "The War Zone BBS"},
-		"franks-palace-bbs":                      {"Frank's BBS", "Frank's Palace BBS"},
-		"dragons-hold-bbs":                       {"The Dragon's Hold BBS"},
-		"ghostship-bbs":                          {"The Ghostship BBS"},
-		"deliberate-meltdown-bbs":                {"PMA WHQ BBS"},
-		"euphoria-bbs":                           {"EU4iA"},
-		"silo-bbs":                               {"The Silo BBS"},
-		"midnight-oil-bbs":                       {"Mid-Nite-Oil BBS", "The Mid Nite Oil BBS", "Mid-Nite Oil BBS"},
-		"hamburger-heavan-bbs":                   {"Hamburger Heaven BBS"},
-		"frayed-ends-of-sanity-bbs":              {"TFEoS", "The Frayed Ends Of Sanity BBS"},
-		"source-bbs":                             {"The Source BBS"},
-		"maniac-bbs":                             {"The Maniac BBS"},
-		"citadel-bbs":                            {"The Citadel BBS"},
-		"untouchables-bbs":                       {"The Untouchables BBS"},
-		"undiscovered-bbs":                       {"The Undiscovered BBS"},
-		"xtc-systems-bbs":                        {"X-T-C System BBS", "XTC"},
-		"ace-bbs":                                {"A.C.E BBS"},
-		"abyss-bbs":                              {"The Abyss BBS"},
-		"ravers-zone-bbs":                        {"The Raver's Zone BBS"},
-		"hms-bounty-bbs":                         {"H.M.S. Bounty BBS"},
-		"demons-forge-ca-bbs":                    {"Demon's Forge CA BBS"},
-		"digital-underground-bbs":                {"The Digital Underground BBS", "TDU BBS"},
-		"state-of-devolution-bbs":                {"Devoltion BBS"},
-		"wall-bbs":                               {"The Wall BBS"},
-		"citadel-of-chaos-bbs":                   {"COC BBS"},
-		"cyberdyne-systems-bbs":                  {"CDS BBS"},
-		"fatal-future-bbs":                       {"FF BBS"},
-		"candyland-bbs":                          {"Candy Land BBS"},
-		"circle_city-bbs":                        {"The Circle-City BBS"},
-		"warp-speed-bbs":                         {"Warp Speed I BBS", "Warp Speed II BBS"},
-		"celestial-woodlands-bbs":                {"The Celestial Woodlands BBS", "C. Woodlands BBS"},
-		"bog-bbs":                                {"The Bog BBS"},
-		"ethereal-dimension-bbs":                 {"The Ethereal Dimension BBS"},
-		"rogues-gallery-bbs":                     {"Rogues' Gallery Bulletin Board System"},
-		"warehouse-bbs":                          {"The Warehouse BBS", "TWH BBS"},
-		"neutral-zone-bbs":                       {"The Neutral Zone BBS"},
-		"viper-pit-bbs":                          {"The Viper Pit BBS"},
-		"festering-pit-of-vile-excretions-bbs":   {"The Festering Pit BBS"},
-		"boners-domain-bbs":                      {"The Boner's Domain BBS"},
-		"circuits-edge-bbs":                      {"The Circuits Edge BBS"},
-		"darkside-bbs":                           {"The Darkside BBS"},
-		"pirate-bbs":                             {"The Pirate BBS"},
-		"warez-houze-bbs":                        {"The Warez Houze BBS", "WaREZ HouZE Super System BBS"},
-		"support-hq-bbs":                         {"The Support HQ BBS", "SHQ BBS"},
-		"solar-system-bbs":                       {"Solar BBS"},
-		"sanitarium-bbs":                         {"The Sanitarium BBS"},
-		"rush-bbs":                               {"The Rush BBS"},
-		"rock-creek-bbs":                         {"The RockCreek BBS"},
-		"private-collection-bbs":                 {"The Private Collection BBS", "TPC BBS"},
-		"notice-bbs":                             {"The Notice BBS"},
-		"next-dimension-bbs":                     {"TND BBS", "The Next Dimension BBS"},
-		"menace-ii-society-bbs":                  {"M2S BBS", "Menace 2 Society BBS"},
-		"atlanta-pcug-bbs":                       {"Atlanta IBM-PCUG BBS", "Atlanta IBM PC User Group"},
-		"board-bbs":                              {"The Board BBS"},
-		"cauldron-bbs":                           {"The Cauldron BBS"},
-		"apocalypse-bbs":                         {"The Apocalypse BBS"},
-		"spyrits-crypt-bbs":                      {"Spyrit's Crypt BBS"},
-		"slave-den-bbs":                          {"The Slave Den BBS"},
-		"software-gallery-bbs":                   {"TSG BBS", "The Software Gallery BBS"},
-		"razors-edge-bbs":                        {"The Razor's Edge BBS"},
-		"carrier-of-belief-bbs":                  {"COB BBS"},
-		"red-or-dead-bbs":                        {"ROD BBS"},
-		"outer-limits-bbs":                       {"The Outer Limits BBS"},
-		"northern-palace-bbs":                    {"The Northern Palace BBS"},
-		"killer-town-bbs":                        {"Killertown BBS"},
-		"junction-bbs":                           {"The Junction BBS"},
-		"hell-hole-bbs":                          {"HellHole BBS"},
-		"elusive-dreams-bbs":                     {"The Elusive Dream BBS"},
-		"deadline-bbs":                           {"The Deadline BBS"},
-		"dark-society-bbs":                       {"TDS BBS", "The Dark Society BBS"},
-		"cloak-n-dagger-bbs":                     {"Cloak & Dagger BBS", "Cloak -N- Dagger BBS"},
-		"crusades-bbs":                           {"The Crusades BBS"},
-		"maximum-rocknroll-bbs":                  {"Maximum Rock'n Roll BBS", "Maximum Rock Roll BBS"},
-		"marines-bbs":                            {"The Marines BBS", "TMB"},
-		"chillout-zone-bbs":                      {"The Chillout Zone BBS"},
-		"agents-of-fortune-bbs":                  {"AOF BBS", "ÆOF BBS"},
-		"manhattan-project-bbs":                  {"The Manhattan Project BBS"},
-		"last-resort-bbs":                        {"The Last Resort BBS"},
-		"fate-gate-bbs":                          {"Fategate BBS", "FG BBS"},
-		"dark-realm-bbs":                         {"The Dark Realm BBS", "TDR BBS"},
-		"pleasure-dome-bbs":                      {"The Pleasure Dome BBS"},
-		"pentagon-bbs":                           {"The Pentagon BBS", "PTG BBS"},
-		"freeside-bbs":                           {"Fastjack's Freeside BBS", "FS BBS"},
-		"beyond-akira-bbs":                       {"Akira BBS", "Beyond BBS"},
-		"park-central-bbs":                       {"PC BBS"},
-		"digital-fringe-bbs":                     {"The Digital Fringe BBS"},
-		"underworld-bbs":                         {"The Underworld BBS", "TUW BBS"},
-		"asylum-bbs":                             {"The Asylum BBS", "ASYL BBS"},
-		"sanctuary-bbs":                          {"The Sanctuary BBS", "Sanct BBS", "SANC BBS"},
-		"retaliators-place-bbs":                  {"RPB", "Retaliator's Place BBS"},
-		"void-bbs":                               {"The Void BBS"},
-		"rusty-n-edies-bbs":                      {"Rusty n Edie's BBS"},
-		"arcade-bbs":                             {"The Arcade BBS"},
-		"hood-bbs":                               {"The Hood BBS"},
-		"house-of-pain-bbs":                      {"HOP BBS", "THOP BBS"},
-		"infinite-darkness-bbs":                  {"ID BBS"},
-		"mirage-bbs":                             {"The Mirage BBS"},
-		"insane-asylum-bbs":                      {"The Insane Asylum BBS", "TIA BBS"},
-		"black-hole-bbs":                         {"The Black Hole BBS"},
-		"badlands-bbs":                           {"The Badlands BBS"},
-		"tower-of-sorcery-bbs":                   {"TOS BBS"},
-		"tribe-bbs":                              {"The Tribe BBS"},
-		"yard-bbs":                               {"The Yard BBS", "TY"},
-		"pits-bbs":                               {"The Pits BBS", "The P.I.T.S. BBS"},
-		"world-of-elite-bbs":                     {"WOE"},
-		"international-software-alliance":        {"ISA"},
-		"dark-side-alliance":                     {"DSA"},
-		"angels-on-drugs":                        {"AOD"},
-		"endreamz":                               {"EDM"},
-		"wad":                                    {"Wad Crew", "WADiSO"},
-		"sos-iso":                                {"SoSiSO", "SoSFXP", "SoSFXP ISO"},
-		"icoiso":                                 {"Independent Couriers Of iSO"},
-		"sharper-image":                          {"SI"},
-		"warez-without-limits":                   {"WWL"},
-		"playmagic":                              {"PLY"},
-		"free-trade-fxp":                         {"FTFiSO", "FTF"},
-		"parents-on-puterz":                      {"POP"},
-		"only-the-finest-warez":                  {"OTFW"},
-		"drift":                                  {"Drift'ers", "DRiFTers"},
-		"slow-trading-droopz":                    {"STD"},
-		"wasteland-ftp":                          {"TWL", "The Wasteland FTP"},
-		"rock-ftp":                               {"The Rock FTP"},
-		"v_i_b_e_s":                              {"VBS", "VIBES"},
-		"intel":                                  {"International Nocturnal Team of Elite Loaders"},
-		"compress-da-audio":                      {"CDA", "Compress 'da Audio"},
-		"bleachbox-ftp":                          {"BBX"},
-		"numbers":                                {"The Numbers", "*NuMbErS*", "NUM"},
-		"the-warez-loop":                         {"The Warez Report", "MindBenders Report"},
-		"zczi":                                   {"SCSI", "sc2i"},
-		"msftug":                                 {"More shit from the underground"},
-		"mortality":                              {"MTY"},
-		"internet-relay-network":                 {"IRN"},
-		"inner-circle":                           {"IC"},
-		"generation-x":                           {"gen-x", "GNX"},
-		"eclipse-interactive":                    {"EPI"},
-		"darkside-couriers":                      {"DSC"},
-		"assimilation":                           {"ASM"},
-		"cellblock-4":                            {"CB4"},
-		"majik":                                  {"MJK"},
-		"masters-of-destruction":                 {"MOD"},
-		"fuck-off-or-die":                        {"FOOD"},
-		"digital-corruption":                     {"DC"},
-		"crc":                                    {"CoRRuPTiON"},
-		"swift-couriering-inc":                   {"SCI"},
-		"ians-rotting-corpse":                    {"IRC"},
-		"lords-of-chaos":                         {"LoC"},
-		"malfunction-system-group":               {"MfSG"},
-		"elite-underground":                      {"EU"},
-		"anarchy-and-armageddon-network":         {"AAA"},
-		"andromeda-software-development":         {"ASD"},
-		"black-widow":                            {"BLW"},
-		"united-artists-association":             {"UAA"},
-		"italian-cracking-service":               {"ICS"},
-		"ddd":                                    {"D.D.D.", "Dr. Death / Darkhawk"},
-		"echo-mirage":                            {"EM"},
-		"elite-programmers-association":          {"EPA"},
-		"casa":                                   {"California Sysop Association"},
-		"union-of-crackers":                      {"UoC"},
-		"game_busters":                           {"BlackMax's Gamebusters Inc."},
-		"bad-association":                        {"BBS's Against Dweebs"},
-		"west-coast-alliance":                    {"WCA"},
-		"warriors-against-copy-protection":       {"WACP"},
-		"the-alternative":                        {"An Alternative release", "Celerity"},
-		"state-of-the-art":                       {"SOTA"},
-		"boogie-down-productions":                {"BDP"},
-		"digital-exchange-pirate-board-alliance": {"DEPBA"},
-		"the-knights-of-the-round-table":         {"TKRT"},
-		"sprint":                                 {"$PRINT"},
-		"black-star-productions":                 {"B*P"},
-		"the-billionarre-boys-club":              {"BBC", "Billionaire Boys Club"},
-		"c-ampersand-m":                          {"C&M"},
-		"united-file-traderz":                    {"UFT"},
-		"european-trading-alliance":              {"ETA"},
-		"best-of-the-best-phreaking-man":         {"BOB"},
-		"hungarian-megacracker-group":            {"HMG"},
-		"inter-active":                           {"IA"},
-		"unreal-reality":                         {"UR"},
-		"success-pc":                             {"SPC"},
-		"the-primal-order":                       {"TPO"},
-		"the-avocado-avengers":                   {"TAA"},
-		"madras":                                 {mad},
-		"mirth":                                  {"MTH"},
-		"bs-enterprize":                          {"B.S. Enterprize"}, //nolint:misspell
-		"osiris":                                 {"ORS"},
-		"dark-force":                             {"DF"},
-		"calculus":                               {"TCG", "The Calculus Group"},
-		"distorted":                              {"DSD"},
-		"cyanide":                                {"CYN"},
-		"german-diskdoubler":                     {"GDD"},
-		"un_touchable-force-organization":        {"UTFO"},
-		"jackshit":                               {"jS"},
-		"187":                                    {"187 Couriers", "One Eight Seven"},
-		"banch-o-guyz":                           {"BOG"},
-		"lamer-of-the-world":                     {"LOTW"},
-		"hasp":                                   {"H.A.S.P."},
-		"roi-production":                         {"ROI", "WaREZ ROI"},
-		"indigo":                                 {"IGD"},
-		"storm-inc":                              {"SM"},
-		"syndrome":                               {"SYD"},
-		"the-cracking-team":                      {"TCT"},
-		uart:                                     {"UART", "URT"},
-		"universal-crime-league":                 {"UCL"},
-		"phase-one":                              {"P1"},
-		"the-damaged-inc":                        {"TDi"},
-		"undercover-agents":                      {"UA"},
-		"atomic-review":                          {"ATM", "Atomic Review Krew"},
-		"ironside-data-productions":              {"iDP"},
-		"italian-crackware-inc":                  {"ICI"},
-		"scd_dox":                                {"SCD", "Software Chronicles Digest / Dox Division"},
-		"needful-things":                         {"NT"},
-		"one-man-courier":                        {"OMC"},
-		"no-bullshit-couriering":                 {"NBC"},
-		"north-american-release-coalition":       {"NARC"},
-		"dual-module-player":                     {"DMP"},
-		"global-overdose":                        {"GOD"},
-		"new-order":                              {"NO"},
-		"butthole-surfers":                       {"BH"},
-		"lightning-force":                        {"LF"},
-		"psychosquad":                            {"PSD"},
-		"rage-against-the-machine":               {"RAM"},
-		"wankers-from-wimbledon":                 {"WW"},
-		"mental-design":                          {"MD"},
-		"jammin-the-airwaves":                    {"JTA"},
-		"never-at-rest-couriers":                 {"NARC"},
-		"siac":                                   {"SiÆC"},
-		"syndicated-network-of-couriers":         {"SyNC"},
-		"sliver-art-products":                    {"SAP"},
-		"virtual-shock":                          {"VS"},
-		"5th-dynasty":                            {"5D"},
-		"paradigm-press":                         {"prdgm"},
-		"corosion":                               {"COR"}, //nolint:misspell
-		"warriors-against-software-protection":   {"WASP"},
-		"no-lamerz-allowed":                      {"NLA"},
-		"anoxia":                                 {"ANX"},
-		"north-american-pirates":                 {"NAP"},
-		"avengers":                               {"AVG"},
-		"computer-pirate-syndicate":              {"CPS", "CP$"},
-		"visual-simulations-inc":                 {"VSI"},
-		"the-golden-triangle":                    {"TGT"},
-		"the-newcomers":                          {"TNC"},
-		"china-syndrome-inc":                     {"CSI"},
-		"housetek":                               {"HTK"},
-		"outlaws-exchange":                       {"OX"},
-		"syndromes-mega-utility-team":            {"SMUT"},
-		"entity":                                 {"ntt"},
-		"ntt":                                    {"NTT"},
-		"quick-silver":                           {"QSR", "QuickSilver"},
-		"pyrodex":                                {"PRX", "Pyrodex PC Division"},
-		"psychedelic-excretion-international":    {"PEi", "Psychdelic Excretion International"},
-		"xtreemer":                               {"XT"},
-		"top-curry-group":                        {"TCG"},
-		"just-the-facts-handheld-edition":        {"JTF"},
-		"weekly-wanking-stats":                   {"WWS"},
-		"pocketheaven":                           {"PH"},
-		"just-week-stats":                        {"JWS"},
-		"some-weekly-chart":                      {"SWC"},
-		"who-owned-weekly":                       {"WoW"},
-		"the-marshall-mussolini-show":            {"TMMS"},
-		"weekly-courier-stats-report":            {"WCSR"},
-		"the-tobacco-brothers":                   {"TTBC"},
-		"orgasming-gaming-magazine":              {"OGM", "ORGAS"},
-		"monthly-cracking-report":                {"MCR"},
-		"zero-second-report":                     {"0SR"},
-		"unbiased-courier-report":                {"UCR"},
-		"the-big-picture-courier-report":         {"TBP"},
-		"prozacs-personal-gaming-report":         {"PPGR"},
-		"gameboycolor-world-charts":              {"GBWC", "GBC World"},
-		"dextrose-chart":                         {"DX"},
-		"couriers-weekly":                        {"CW"},
-		"corrupt-console-diskmag":                {"CORR"},
-		"actual-factual-couring":                 {"AfC"},
-		"frontline-scene-release-report":         {"FTL"},
-		"future-scene-news":                      {"FSN"},
-		"nuclear-crackers":                       {"NC"},
-		nwo:                                      {"NWO"},
-		"scene-top-traders":                      {"STT"},
-		"weekly-courier-report":                  {"WCR"},
-		"top-telnet-traders-weekly":              {"TTW"},
-		"the-legendary-report":                   {"TLR"},
-		"resistance":                             {"RSE"},
-		mcsci:                                    {"MCSCI"},
-		"zenith-zine":                            {"ZZ"},
-		"canadian-born-coders":                   {"CBC"},
-		"virus-laboratories-and-distribution":    {"VLAD"},
-		"higher-mental-plane":                    {"hmp"},
-		"dutch-trader-charts":                    {dtc},
-		"bbs-and-users-digest":                   {"BAUD"},
-		"trip-2-hell":                            {"T2h"},
-		"strictly-pirates":                       {"SP", "SP!"},
-		"software-runners-from-hell":             {"SRH"},
-		"software-pirates-alliance":              {"SPA"},
-		"rebels-of-telecommunications":           {"ROT"},
-		"quality-control-reviews":                {"QC"},
-		"evolution-magazine":                     {"EVO", "EVOL"},
-		"digital-press":                          {"DP"},
-		"bizarre-types-of-wares":                 {"BTW"},
-		"underground-experts-united":             {"UXU"},
-		"the-review-crew":                        {"TRC"},
-		"poison-control":                         {"PCi"},
-		"magick":                                 {"MGK", "MUDD", "M.U.D.D.", "Magick Utilities & Demo's Division"},
-		"infinity-93":                            {inf},
-		"foundation":                             {"FDN"},
-		"thhg":                                   {"The Hugo Husten Group", "The Horrible Hackers from Germany"},
-		"2000ad":                                 {"2KAD", "2000 AD"},
-		"8088-state":                             {"8088"},
-		"aces-of-ansi-art":                       {"AAA"},
-		"acid-productions":                       {"ACiD", "ANSi Creators in Demand"},
-		"addiction-in-releasing":                 {"AiR"},
-		"advanced-art-of-cracking-group":         {"AAOCG"},
-		"advanced-pirate-technology":             {"APT"},
-		"advanced-software-accessories":          {"ASA", "Advantage Software Accessories"},
-		"adrenalin":                              {"Adren"},
-		"affinity":                               {"AFT"},
-		"aegis-corp":                             {ags},
-		"air":                                    {"Team AiR", "AiRISO"},
-		"arkham":                                 {"AKM"},
-		"alpha-flight":                           {"AFL"},
-		"ages":                                   {ags},
-		"against-software-protection":            {"ASP"},
-		"aggression":                             {"ARN", ags},
-		"amnesia":                                {"AMN"},
-		"american-pirate-industries":             {"API"},
-		"amplified-music-pirates":                {"AMP"},
-		"anemia":                                 {"ANM"},
-		"ansi-requires-talent":                   {"ART"},
-		"anti-warez-association":                 {"AWA"},
-		"anthrox":                                {"ATX", "AS"},
-		"anti-security-agency":                   {"ASA"},
-		"anti-lamers-foundation":                 {"ALF"},
-		"anarchy-international-production":       {"AIP"},
-		"ansi-factory":                           {"AFC"},
-		assign:                                   {"ASSiGN"},
-		"association-of-software-conspiracy":     {"ASC"},
-		"astalavista-group":                      {"ASTA"},
-		"arab-team-4-reverse-engineering":        {"AT4RE"},
-		"arrogant-couriers-with-essays":          {ace},
-		"art-of-reverse-engineering":             {"AORE"},
-		"artists-in-revolt":                      {"AiR"},
-		"argies-courier-united":                  {"ACU"},
-		"atari-pirates-incorporated":             {"API"},
-		"atlantic-trading-alliance":              {"ATA"},
-		"attack-decay-sustain-release":           {"ADSR", "AR"},
-		"artists-without-loyality":               {"AWoL"},
-		"arcane-corporate-elite":                 {ace},
-		"backlash":                               {"BLH"},
-		"bad-ass-dudes":                          {"BAD"},
-		"bad-news":                               {"BaD", "B.A.D. Newsletter", "The BAD News"},
-		"baywatch":                               {"BWH"},
-		"bentley-sidwell-productions":            {"BSP"},
-		"belly-are-kiss-attack":                  {"BAKA"},
-		"beverly-hills-boys":                     {"BHB"},
-		"blades-of-steel":                        {"BOS", "Blades"},
-		"black-out":                              {"BLOT"},
-		"black-riders":                           {"BRD"},
-		"black-squadron":                         {"BS"},
-		"blaze":                                  {"BLZ"},
-		"blizzard":                               {"BLZ", "blizz"},
-		"blue-beta-3d":                           {"BB3D"},
-		"bitchin-ansi-design":                    {"BAD"},
-		"binaries":                               {"BiN"},
-		"billionaire-boys-club":                  {"BBC"},
-		"break-the-copyright":                    {"BTCR"},
-		"brotherhood-of-warez":                   {"BOW"},
-		"brotherhood-union":                      {"BHU"},
-		"boys-from-company-c":                    {"BCC"},
-		"bonzai":                                 {"BNZ"},
-		"buds-biased-utils-report":               {"utils"},
-		"bytegarden":                             {"BTG"},
-		"canadian-pirates-inc":                   {"CPI"},
-		"cancer":                                 {cnc},
-		"car-e-lee":                              {"CEL"},
-		"cascada":                                {"CDA"},
-		"cardinals":                              {"CDS"},
-		"cd-images-for-the-elite":                {"CiFE"},
-		"celerity-utilities-division":            {"CUD"},
-		crue:                                     {"CRUE"},
-		"chaos":                                  {"CHS"},
-		"class":                                  {cls},
-		"classic":                                {cls},
-		"classic-cracking-corporation":           {"CCC"},
-		"club-elan":                              {"CE", "Club Elán"},
-		"chaos-cyber-creations":                  {"CCC"},
-		"chemical-reaction":                      {"CRO"},
-		"chinese-software-distribution-network":  {"CSDN"},
-		"codex":                                  {"CDX"},
-		"coders-task-force":                      {"CTF"},
-		"coolphat-vibez":                         {"CPHV"},
-		"coop":                                   {"The Dream Team + Tristar + Red Sector Inc.", "The Co-op"},
-		"community-of-moral-advancement":         {"COMA", "CMA"},
-		"conspiracy":                             {"CSY", "CPY"},
-		"contour":                                {"CTR"},
-		"console-supply-iso":                     {"CSISO"},
-		"console-gaming-informers":               {"CGI"},
-		"copycats-inc":                           {"CCI"},
-		"copyright-infiltration-agency":          {cia},
-		"corruption":                             {"COR"},
-		"corporate-graphics":                     {"CGX"},
-		"corrupted-programming-international":    {"CPI"},
-		"criminals-of-radical-extremes":          {"CORE"},
-		core:                                     {"CORE"},
-		cpc2001:                                  {"C.P.C. 2001", "CPC", "CPC 2001"},
-		"couriers-of-pirated-software":           {"COPS"},
-		"couriers-of-proven-software":            {"COPS"},
-		"courier-weektop-scorecard":              {"CWS"},
-		"couriers-of-darkness":                   {"COD"},
-		"crack-report-weekly":                    {"CRW"},
-		"cowboys-from-hell":                      {"CFH"},
-		"crackers-and-hackers-anonymous":         {"CHA"},
-		"crackers-in-action":                     {cia},
-		"crackers-international-alliance":        {cia, "Crackers Int'l Alliance"},
-		"crackpl":                                {"CP"},
-		"crack-in-morocco":                       {"CiM"},
-		"cracking-for-fun":                       {"CFF"},
-		"cracking-101":                           {"C101"},
-		"cracking-in-ocean":                      {"CiO"},
-		"crackers-ampersand-whackers":            {"C&W", "CW"},
-		"crazyworld":                             {"CZW"},
-		"creeping-death-software":                {"CDS"},
-		"crime-syndicate-net":                    {"TCS"},
-		"crystal-phasematics":                    {"CPM"},
-		"cryptonics-crew":                        {"CTC"},
-		"texas-chainsaw-massacre-bbs":            {"TCM BBS"},
-		"what-the-bbs":                           {"What The..?! BBS"},
-		"creators-of-intense-art":                {cia},
-		"covert-action-ii-bbs":                   {"Covert Action 2 BBS", "CA2 BBS"},
-		"creators-of-revolutionary-pictures":     {"CORP", "CφRP", "CRP", "C.O.R.P"},
-		"crude":                                  {"CRD"},
-		"cti":                                    {"Crude & TDK iNC"},
-		"cybrix":                                 {"CBX", "Cybrix Couriering"},
-		"cygnus":                                 {"CYG"},
-		"cybermail":                              {"CM"},
-		"cyber-force":                            {"CF"},
-		"cyber-legion":                           {"CL"},
-		"cybercrime-international-network":       {"CCi", "CyberCrime Inc."},
-		"darksiders":                             {"DS"},
-		"da-breaker-crew":                        {"DBC"},
-		"dbcdemo":                                {"DBC", "Da Breaker Crew Demo Division"},
-		"dagger":                                 {"DGR"},
-		"damage-incorporated":                    {"Di"},
-		"damn-excellent-ansi-design":             {"DeAD"},
-		"damn-excellent-ansi-designers":          {"DeAD"}, // Correct
-		"darkside-incorporated":                  {"DSI"},
-		"dark-towers-international":              {"DTI"},
-		"delirium-tremens-group":                 {"DTG"},
-		"dead-memory":                            {"DM"},
-		"dead-on-arrival":                        {doa},
-		"dead-pirates-society":                   {"DPS"},
-		"decrepit-old-geezers":                   {"DOG"},
-		"defacto":                                {"DF"},
-		"defacto2":                               {"DF2", "DF"},
-		"defacto2net":                            {"DF2"},
-		"defiant":                                {"DFT"},
-		"demented-dimensions":                    {"DD"},
-		"delirium-of-disorder":                   {"DoD"},
-		"demon-release-crew":                     {"DRC"},
-		"desperate-turk-crackers":                {"Desperate", "DP"},
-		"destined-masters-of-zines":              {"DMZ"},
-		"digital-factory":                        {"DF"},
-		"disciples-of-private-enterprise":        {"DOPE"},
-		"disciples-of-the-dark-knight":           {"DDK"},
-		"disire":                                 {"DSR"},
-		"dead-weight":                            {"DW"},
-		"dead-or-alive":                          {doa},
-		"deviance":                               {"DEV", "DVN", "DVNiSO"},
-		"divine":                                 {"DVN", "Divine Gods", "Divine ISO", "DVNISO", "DIVINEISO"},
-		"dinobytes":                              {"DiNO-BYTES", "Dino Bytes"},
-		"cadmium":                                {"cdm"},
-		"phxiso":                                 {"Phoenix ISO"},
-		"details":                                {"DTS"},
-		"the-kennal-club":                        {"TKC", "KC"},
-		"deviated":                               {"DVT"},
-		"devotion":                               {"DEV", "devot"},
-		"digerati":                               {"DGT"},
-		"digital-artists-of-the-rare-kind":       {"DARK"},
-		"digital-insanity":                       {"DI"},
-		"digital-millennium-cracking-alliance":   {"DMCA"},
-		"digital-noise-alliance":                 {"DNA"},
-		"direct-from-stars":                      {"DFS"},
-		"direction-simple-la-kamisole":           {"DSK"},
-		"dimension":                              {"DMS"},
-		"distinct":                               {dtc, "DTN"},
-		"dislocated-babes":                       {dst},
-		"disassemblers-of-america":               {doa},
-		"distributors-of-classic-warez":          {"DCW"},
-		"divide-by-zero":                         {"DBZ"},
-		"domination-in-couriering":               {"DiC"},
-		"downtown-hackers-crew":                  {"D.H Crew", "DHC"},
-		"dreadloc":                               {"DLC"},
-		"dream-team":                             {"DT"},
-		"dream-syndicate":                        {"DS"},
-		"drunken-rom-group":                      {"DRG", "Drunken"},
-		"drone":                                  {"DRN"},
-		"drink-or-die":                           {"DOD"},
-		"dvt":                                    {"Devotion", "TeamDVT"},
-		"deadly-underground-network-of-elites":   {"DUNE"},
-		"dutch-computer-enterprise":              {"DCE"},
-		"doc-writers-inc":                        {"DWI"},
-		"dynasty":                                {"DYN", "DNS"},
-		"same-shit-different-day":                {"SS-DD"},
-		"dynamix":                                {"DNX"},
-		"dytec":                                  {"DYT", dtc},
-		"eagle-soft-incorporated":                {"ESI"},
-		"ebola-virus-crew":                       {"EVC"},
-		"eclipse":                                {"ECL"},
-		"east-coast-connection":                  {"ECC"},
-		"eximius":                                {"XMS"},
-		"extasy":                                 {"EX"},
-		"exodus-couriers":                        {"Exodus Couriering"},
-		"elite-carding-network":                  {"ECN"},
-		"empire-of-darkness":                     {"EOD"},
-		"embrace":                                {"EMB"},
-		"electro-magnetic-crackers":              {"EMC"},
-		"electromotive-force":                    {"EMF"},
-		"electronic-rats":                        {"ECR"},
-		"empire":                                 {"EMP"},
-		"emporio":                                {"EMP"},
-		"end-of-file":                            {"EOF"},
-		"energy":                                 {"NRG"},
-		epix:                                     {"EPIX"},
-		"equinox":                                {"EQX"},
-		"esprit-couriers":                        {"Esprit"},
-		"esp-pirates":                            {"ESP"},
-		"eternity":                               {"ETE"},
-		"eithel":                                 {"ETH"},
-		"excess":                                 {"ECS"},
-		"exceptional":                            {"XCP"},
-		"excessive-force-crew":                   {"EFC"},
-		"explosion":                              {"EPN"},
-		"extinct":                                {"EX"},
-		"extreme":                                {"EXT"},
-		"extreme-team":                           {"ET"},
-		"extreme-graphix-alliance":               {"XGA"},
-		"exterminators":                          {"TEX"},
-		"extreme-trading-crew":                   {"ETC"},
-		"evidence":                               {"EVD"},
-		"euphoria":                               {"EPH"},
-		"fairlight":                              {"FLT"},
-		"fairlight-dox":                          {"FDX", "FLTDOX", "FAIRDOX"},
-		"faith":                                  {"FTH"},
-		"fallen":                                 {"FLN"},
-		"fatal":                                  {"FTL"},
-		"fatbastards":                            {"FB"},
-		"fantastic-4-cracking-group":             {"F4CG"},
-		"fast-action-trading-elite":              {"fATE"},
-		"faster-than-light-couriers":             {"FLC"},
-		"fatigued-couriers-network":              {"FCN"},
-		"federal-cracking-consortium":            {"FCC"},
-		"fast-elite-distributors-of-software":    {"FEDS"},
-		"fighting-for-fun":                       {"fff"},
-		"fight-only-for-freedom":                 {"FOFF"},
-		"file-rappers":                           {"FR"},
-		"fistful-of-steel":                       {"FOS"},
-		"five-o":                                 {"Five 0"},
-		"flying-horse-cracking-force":            {"FHCF"},
-		"free-on-the-line":                       {"FOTL"},
-		"freelancers-guild":                      {"FRL"},
-		"fucked-beyond-repair":                   {"FBR"},
-		"future-crew":                            {"FC"},
-		"future-brain-inc":                       {"FBi", "FBIA"},
-		"futuristic-artists-with-talent":         {"FAT"},
-		"fusion":                                 {"FSN"},
-		"galactic-review":                        {"GALA"},
-		"game-release-list":                      {"Releases by Claude Rains"},
-		"grave-yard-crew":                        {"GYC"},
-		"gencliq":                                {"GCT"},
-		"german-cracking-group":                  {"GCG"},
-		"german-consoles-syndicate":              {"GCS"},
-		"german-trading-alliance":                {"GTA"},
-		"german-warez-alliance":                  {"GWA"},
-		"genesis-ppe":                            {"GNS"},
-		"genesis":                                {"GNS"},
-		"genesis-project":                        {"GP"},
-		"guild-of-distributors":                  {"GODS", "GDS"},
-		"glory":                                  {"Glory Couriers", "GL"},
-		"god-damn-warez":                         {"GDW"},
-		"gorgeous-ladies-of-warez":               {"GLOW", "GlowISO"},
-		"hrps":                                   {"H.R. Puppystuff"},
-		"the-free-loaders":                       {"TFL"},
-		"heritage":                               {"HTG"},
-		"gothic":                                 {"GTHC"},
-		"goofy-illitape-softwarez":               {"GIS"},
-		"graphic-revolution-in-progress":         {"GRiP"},
-		"grand-old-pirates":                      {"GOP"},
-		"elite-couriers-group":                   {"ECG"},
-		"epsilon":                                {"EPS"},
-		"fatal-connection":                       {"FC", "FATAL"},
-		"fawkes":                                 {"FWK"},
-		"fasiso":                                 {"FAS"},
-		"file-propulsion-system":                 {"FPS"},
-		"friendship":                             {"FRD", "Friends"},
-		"federation-of-free-traders":             {"FOFT"},
-		"fyllecell":                              {"FLC"},
-		"future-ansi-creations":                  {"FAiC"},
-		"graphically-enhanced-magazine":          {"GEM"},
-		"glorious-console-master-race":           {"GCMR"},
-		"ghost-riders":                           {"GRS"},
-		"god-of-war":                             {"GOW"},
-		"graphics-rendered-in-magnificence":      {"GRiM"},
-		"gainseville-pirates-association":        {"GPA"},
-		"hard-to-beat-team":                      {"HTB"},
-		"hard-core-hackers":                      {"hCh"},
-		"halcyon":                                {"HLN"},
-		"hackers-with-attitude":                  {"HWA"},
-		hate:                                     {"HaTe"},
-		"hearts-in-the-shadows":                  {"HiTS"},
-		"hardcore-elite-mother-phuckers":         {"HEMP", "HMP"},
-		"haze":                                   {"HZ"},
-		"highroad":                               {"HR"},
-		"high-society":                           {"HS"},
-		"high-voltage":                           {"HV", "HVC", "VOLT"},
-		"high-speed-couriers":                    {"HSC"},
-		"high-tech-couriers":                     {"HTC"},
-		"high-speed-global-mass-trading":         {"HSGMT"},
-		"hipe":                                   {"HPE"},
-		"hoodlum":                                {"HLM"},
-		"hooligans":                              {"HLG"},
-		"horizon":                                {"HZN"},
-		"humble-dox":                             {"The Humble Guys DOX"},
-		"hummers":                                {"HUM"},
-		"hybrid":                                 {"HBD"},
-		"gobble":                                 {"GOB"},
-		"grind":                                  {"GND"},
-		"imperial-falcon":                        {"IF"},
-		"the-unorginal-bastards":                 {"TUB"},
-		"hysteria":                               {"HSA"},
-		"hype":                                   {"HYP"},
-		"keen-like-frogs":                        {"KLF"},
-		"kryptonic-hacking-team":                 {"KHT"},
-		"kalisto":                                {"KAL"},
-		"krackass":                               {"KASS"},
-		"katharsis":                              {"KTS"},
-		"kosmic-loader-foundation":               {"KLF"},
-		"knights-of-the-round-table":             {"KORT"},
-		"kyrie-eleison":                          {"KE", "KEISO"},
-		"kryn":                                   {"KRN"},
-		"idiots-creations-unlimited":             {"iCU"},
-		"illuminatus":                            {"ILL"},
-		"illusion":                               {"iLL"},
-		"infinity-e_mag":                         {inf},
-		"ifranian-rebellious-hackers":            {"IRH"},
-		iirg:                                     {"IIRG"},
-		"immersion":                              {"IMS"},
-		"independent":                            {"IND", "individual"},
-		"independent-crackers-union":             {"ICU"},
-		"independant-cracking-institute":         {"ICI", "Independent Cracking Institute"}, //nolint:misspell
-		"indonesia-reversing-crew":               {"IRC"},
-		"intension":                              {"ITN"},
-		"international-ghost-hunters":            {"IGH"},
-		"international-network-of-crackers":      {"INC"},
-		"inc-europe":                             {"INC", inc},
-		"international-cracking-crew":            {"iCC"},
-		"inc-documentation-division":             {"IDD", inc},
-		"inc-utility-division":                   {"IUD", inc},
-		"interpol":                               {"IPL"},
-		"image":                                  {"IMG"},
-		"imphobia":                               {"IMP"},
-		"impact":                                 {"IMP"},
-		"influence":                              {"iNF"},
-		"infinity":                               {inf},
-		"infinity-trainers-unlimited":            {"ITU"},
-		"information-liberation-league":          {"ILL"},
-		"international-software-traders":         {"IST"},
-		"insanity":                               {"Insan"},
-		"inquisition":                            {"INQ"},
-		"interceptor":                            {"INT"},
-		"invisible":                              {"INV"},
-		"iranian-crackers-association":           {"ICA"},
-		"iridium-magazine":                       {"IRIDI"},
-		"jrp":                                    {"JRP"},
-		jest:                                     {"JEST"},
-		"justiso":                                {"JI"},
-		"just-for-fun":                           {"JFF", "J4F", "Just 4 Fun"},
-		"just-for-phun":                          {"J4P"},
-		"just-the-facts":                         {"JTF"},
-		"lamers-of-power":                        {"LOP"},
-		"lancelot":                               {"LANCE"},
-		"lancelot-2":                             {"LANCE"},
-		"llange-art-reptareeko":                  {"LAR"},
-		"last-poets-society":                     {"LPS"},
-		"laxity":                                 {"LXT"},
-		"lethal-software-distributors":           {"LSD"},
-		"legacy":                                 {"LGC", "LGY"},
-		"legend":                                 {"LGD", "Legend PC", "PC/Legend"},
-		"legends-never-die":                      {"LND"},
-		"legion-of-doom":                         {"LOD", "LOH", "LOD/H"},
-		"legion-of-dynamic-discord":              {"LODD"},
-		"legion-of-rising-distributors":          {"LORD"},
-		"inferno":                                {inf},
-		"licensed-to-draw":                       {"LTD"},
-		"little-big-one":                         {"LBO"},
-		"light-speed-distributors":               {"LSD"},
-		"light-speed-warez":                      {"LSW"},
-		"lightforce":                             {"LFC", "LF"},
-		"lithium":                                {"LIT"},
-		"linezer0":                               {"Lz0", "Linezero"},
-		"live-now-die-later":                     {"LnDL"},
-		"lockless":                               {"LKL"},
-		"legion-of-the-etherial":                 {"LOTE"},
-		"local-courier-system":                   {"LCS"},
-		"living-organisms-on-mars":               {"LOOM"},
-		"los-angeles-sysops-alliance":            {"LASA"},
-		"lucid":                                  {"LCD"},
-		"lucifer":                                {"LUC"},
-		"mack-crack-corporation":                 {"MCC"},
-		"masters-of-abstractions-and-illusions":  {"MAi"},
-		"malicious-art-denomination":             {mad},
-		"malondorous-griffin-entanglement":       {"MGE"},
-		"malice":                                 {"MAL"},
-		"majic-12":                               {"M12"},
-		"magnificent-art-designers":              {mad},
-		mash:                                     {"MASH"},
-		"masters-of-the-art-experience":          {"MAX"},
-		"masque":                                 {"MSQ"},
-		"master-artists-guild-for-the-elite":     {"MAGE"},
-		"mea-culpa":                              {"MC"},
-		"medeival-emporium-of-warez":             {"MEOW"}, //nolint:misspell
-		"menaceiisociety":                        {"MiiS"},
-		"millenium":                              {"MnM", "MiLLENNiUM"}, //nolint:misspell
-		"mutual-assured-destruction":             {mad},
-		"manifest":                               {"MFD", "Manifest Destiny"},
-		mgsci:                                    {"MGSCI"},
-		"mercury":                                {"MERC"},
-		"more-stupid-initials":                   {"MSI"},
-		"motiv8":                                 {"M8"},
-		"micropirates-inc":                       {"MPI"},
-		"mickey-mouse-club":                      {"MMC"},
-		"mirage":                                 {"MIR"},
-		"miami-cracking-machine":                 {"MCM"},
-		"mindcrash":                              {"MC"},
-		"modders-on-drugs":                       {"MOD"},
-		"myth-inc":                               {"M.Y.T.H. Inc", "MYTH Inc Link BBS"},
-		"myth*deviance":                          {"MDVN"},
-		"myth":                                   {"MYT"},
-		"nah-kolor":                              {"NAH"},
-		neua:                                     {"NEUA", "North Eastern Underground Alliance"},
-		nuaa:                                     {"NUAA"},
-		"napalm":                                 {"NPM"},
-		"national-crackers-alliance":             {"NCA"},
-		"national-distribution-network":          {"NDN"},
-		nnan:                                     {"NNAN"},
-		"nerve":                                  {"NRV"},
-		"netrunners":                             {"NR"},
-		"network-software-association":           {"NSA"},
-		"new-york-crackers":                      {"NYC"},
-		"new-world-order":                        {"NWO"},
-		"nexus":                                  {"NXS", "NX"},
-		"nemesis":                                {"NMS"},
-		"nokturnal-trading-alliance":             {"NTA"},
-		"no-fear":                                {"NF"},
-		"noobs-reverser-team":                    {"NBR"},
-		"nordic-engineering-corporation":         {"NEC"},
-		"north-eastern-crackers":                 {"NEC"},
-		nappa:                                    {"NAPPA", "NAP/PA", "NAPE"},
-		"nintendo-backup-crew":                   {"NBC"},
-		"nrp":                                    {"NRP"},
-		nasa:                                     {"NASA"},
-		"nc_17":                                  {"NC"},
-		"national-software-network":              {"NSN"},
-		"norwegian-cracking-company":             {"NCC"},
-		"noclass":                                {"NoCLS", cls},
-		"not-productions":                        {"NOT!"},
-		"objectile":                              {"OCT"},
-		"oceanine":                               {"OCN"},
-		"oddity":                                 {"ODT"},
-		"old-warez-inc":                          {"OWI", "OldWarez Inc"},
-		"oldskool":                               {"OS"},
-		"old-school-pirates":                     {"OSP"},
-		"oneup":                                  {"1UP", "One Up"},
-		"on_line-revenge":                        {"OLR", "Online Revenge"},
-		"orion":                                  {"ORN"},
-		"origin":                                 {"OGN"},
-		"originally-funny-guys":                  {"OFG"},
-		"our-nefarious-endeavor":                 {"ONE"},
-		"out-rage-pirates":                       {"ORP"},
-		"outcast":                                {"OUT"},
-		"outlaws":                                {"OTL", "OUT"},
-		"overkill":                               {"OVL"},
-		"p2psaurus":                              {"PS"},
-		"pirates-against-purchasing-software":    {"PAPS"},
-		"partners-in-crime":                      {"PiC"},
-		"paradigm":                               {"PDM", "Zeus", "PDMISO", "Paradigm ISO"},
-		"paradox":                                {"PDX"},
-		"pc_cracking-service":                    {"PC-CS", "PCCS"},
-		"pe*trsi*tdt":                            {"Public Enemy + Tristar + Red Sector + The Dream Team"},
-		"pentagram":                              {"PTG"},
-		"pentium-force-team":                     {"PFT"},
-		"persian-genius-team":                    {"PGteam"},
-		"phoenix":                                {"PHX"},
-		"phreakerz-against-commerce":             {"PAC"},
-		"pirates-club-inc":                       {"PC INC"},
-		"phrozen-crew":                           {"PC"},
-		"pirates-cove":                           {"PC"},
-		"pirates-analyze-warez":                  {"PAW"},
-		"pirates-gone-crazy":                     {"PGC"},
-		"pirates-in-legion":                      {"PiL"},
-		"pirates-sick-of-initials":               {"PSi"},
-		"pirates-with-attitudes":                 {"PWA"},
-		"pirates-releasing-in-mass-extremes":     {"PRiME"},
-		"pizza-dox":                              {"PizzaDOX"},
-		"plate-steel-productions":                {"PSP"},
-		"police":                                 {"PLC"},
-		"power-crisis-international":             {"PCI"},
-		"portable-apps-crew-europe":              {"PACE"},
-		"psycho-corporate-productions":           {"PCP"},
-		"predator-666":                           {"PRD666"},
-		"primal":                                 {"PML"},
-		"prophecy":                               {"PCY"},
-		"propaganda":                             {"PROP"},
-		"prozac":                                 {"PRO"},
-		"ptl-club":                               {"PTL"},
-		"postmortem":                             {"PM"},
-		"prestige":                               {"PSG", "PST"},
-		"protection-fucking-sucks":               {"PFS"},
-		"psycho-squad":                           {"PSD"},
-		"public-brand-software":                  {"PBS"},
-		"public-enemy":                           {"PE"},
-		"pyradical":                              {"PYR"},
-		"quartex":                                {"QTX", "Quartex PC"},
-		quick:                                    {"QUICK"},
-		"radical-elite-movement":                 {"REM"},
-		"really-awful-music":                     {"RAM"},
-		"rage":                                   {"Rage'94"},
-		"razordox":                               {rzr, razor, "Razor DOX", "Razor 1911 Documentation Division"},
-		"razor-1911":                             {rzr, razor, "Razor CD", "Razor CD Division"},
-		"razor-1911-demo":                        {rzr, razor},
-		"real-life-then-scene":                   {"RLTS"},
-		"real-crazy-artists":                     {"RCA"},
-		"real-cocoheads":                         {"RC"},
-		"reality-check-network":                  {"RCN"},
-		"real-time-pirates":                      {"RTP"},
-		"rebels":                                 {"RBS"},
-		"r2":                                     {"R2", "RTWO"},
-		"recoil":                                 {"RCL"},
-		"red-sector-inc":                         {"RSI"},
-		"red-green-blue":                         {"RGB"},
-		"release-on-rampage":                     {"RoR"},
-		"relic":                                  {"REL"},
-		"reloaded":                               {"RLD"},
-		"relativity":                             {"REV"},
-		"reflux":                                 {"RLX"},
-		"relentless-pursuit-of-magnificence":     {"RPM", "Relentlessly Pursuing Magnificence"},
-		"republic-banana":                        {"RB"},
-		"request-to-send":                        {"RTS"},
-		"renaissance":                            {"RNS", "RSS"},
-		"reign-of-terror":                        {"RoT"},
-		"resistance-is-futile":                   {"RiF"},
-		"rescue-raider":                          {"RR", "RR INC", "TDI"},
-		"resurrection":                           {"RSR", "RES"},
-		"revelation":                             {"RVL"},
-		"review-of-aquired-warez":                {"RAW"}, //nolint:misspell
-		"revenge-crew":                           {"REV"},
-		"revolution-project":                     {"RP"},
-		"reverse-2-revolutionize":                {"R2R"},
-		"reverse-engineers-dream":                {"RED"},
-		"reverse-engineering-in-software":        {"REiS"},
-		"reverse-engineering-passion-team":       {"REPT"},
-		"reviving-intelligent-fast-trading":      {"RiFT"},
-		"revolution":                             {"RVL", "RTN"},
-		rampage:                                  {"RAMPAGE"},
-		"rise-in-superior-couriering":            {"RiSC"},
-		"risciso":                                {"RiSC"},
-		"rom-1911":                               {"Razor 1911 CD-ROM Division"},
-		"romkids":                                {"RMK"},
-		"russian-trading-alliance":               {"RTA"},
-		"sanxion":                                {"SXN"},
-		"saints-and-sinners-group":               {"SSG"},
-		"seek-n-destroy":                         {"SND"},
-		"scene-charts":                           {"SC"},
-		"skid-row":                               {"SR", "Skidrow"},
-		"scoopex":                                {"SCX", "SPX"},
-		"scooby-snack-magazine":                  {"SSM"},
-		"scandal":                                {"SCL"},
-		scud:                                     {"SCUD"},
-		"scenenotice":                            {"SCN"},
-		"scienide":                               {"SCi"},
-		"sea-shell-commando":                     {"SSC"},
-		"serials-2000":                           {"S2K"},
-		"software-exchange":                      {"SEX", "SOFT-EX"},
-		"silicon-dream-artists":                  {"SDA"},
-		"shallow-grounds":                        {"SG"},
-		"share-and-enjoy":                        {"SAE"},
-		"shore-cracking-ansi-runners":            {"SCaR"},
-		"shmeitcorp":                             {"Shmeit Corp", "SC"},
-		"shitonlygerman":                         {"SOG", "Scheisse Deutsch Only"},
-		"skillion":                               {"SKN"},
-		"shiver":                                 {"SHV"},
-		"siege":                                  {"SG"},
-		"silent-cracking-force":                  {"SCF"},
-		"silent-cracking-service":                {"SCS"},
-		"sinister":                               {"SiN"},
-		"slaves-of-pain":                         {"SoP"},
-		"smokers-in-krime":                       {"SiK"},
-		"smegma":                                 {"SMG"},
-		"society-of-sharing":                     {"SOS"},
-		"society-of-suckers":                     {"SOS"},
-		"sodom":                                  {"SDM"},
-		"solitudes":                              {"SLT"},
-		"some-lonesome-ansi-makers":              {"SLAM"},
-		"sorcerers":                              {"SOR"},
-		"software-liberation-army":               {"SLA"},
-		"software-pirating-coalition":            {"SPC"},
-		"software-chronicles-digest":             {"SCD"},
-		"software-in-danger":                     {"SID"},
-		"software-pirates-inc":                   {"SPI", "Software Pirates"},
-		"south-eastern-elite":                    {"SEE"},
-		"spetznas":                               {"spetznaz"},
-		"spazm":                                  {"SPZ", "SPZM", "Spazm/VGA"},
-		"spazm-couriers":                         {"SPZ", "SPZM", "SPAZM"},
-		"spectrum":                               {"SPEC"},
-		"standards-of-piracy-association":        {"SPA"},
-		"static":                                 {"STC"},
-		"superior-art-creations":                 {"SAC"},
-		"surprise-productions":                   {"SP"},
-		"suicide-is-painless":                    {"SiP"},
-		"synapse":                                {"SYN"},
-		"syndicate-of-dreams":                    {"SOD"},
-		"sysop-support-network":                  {"SSN"},
-		"sma-posse":                              {"$MA"},
-		"syndicate":                              {"SYN"},
-		"the-syndicate":                          {"$ynd", "The $yndicate", "The $yndacite"},
-		"talent-entertainment":                   {"TLN"},
-		"tdu_jam":                                {"TDU-Jam!", "TDU", "TDUJAM", "TDU JAM"},
-		"starlight":                              {"SLT"},
-		"scum":                                   {"S.C.U.M"},
-		"crazy-nation":                           {"CZN"},
-		"global-piracy-foundation":               {"GPF"},
-		"orbital-one-three":                      {"O13"},
-		"secret-warez-people":                    {"SWP"},
-		"quantum":                                {"QTM"},
-		"repulsion":                              {"RSP"},
-		"soncrap":                                {"SC"},
-		"axis":                                   {"AX"},
-		"bomb-squad":                             {"BS"},
-		"cryp70nym":                              {"CrY"},
-		"13-omens":                               {"13o"},
-		"national-pirate-list":                   {"Bounty"},
-		"crime":                                  {"CR", "CRM"},
-		"countdown":                              {"CNT", "Count Down"},
-		"skeleton-army":                          {"SKL", "The Skeleton Army"},
-		"winterhawk-dupe-list":                   {"Wintr", "Big Bird", "BigBird", "BB"},
-		"dragon":                                 {"DGN"},
-		"team-edge":                              {"EDGE"},
-		"team-technotrogens":                     {"TT3", "Team T3"},
-		"technobrains":                           {"TCB"},
-		"terratron":                              {"TERR"},
-		"terror-zone-underground":                {"TZU"},
-		"tekno-rage-ampersand-pirasoft":          {"TRPS"},
-		"the-amatuer-crackist-tutorial":          {"ACT"}, //nolint:misspell
-		"the-artists-guild":                      {"TAG"},
-		"the-black-star":                         {"TBS"},
-		"the-bitter-end":                         {"TBE"},
-		"the-brain-slayer":                       {"TBS"},
-		"the-buyers-group":                       {"TBG"},
-		"the-brotherhood-of-gods-and-retards":    {"BGR"},
-		"the-care-company":                       {tcc},
-		"the-canadian-crackers":                  {tcc},
-		"the-chronic-krackers":                   {"TCK"},
-		"the-cracking-answer":                    {tca},
-		"the-crazed-asylum":                      {tca},
-		"the-cracking-clan":                      {tcc},
-		"the-cradle-traders":                     {"TCT"},
-		"the-codeblasters":                       {"TCB"},
-		"the-console-division":                   {"TCD"},
-		"the-corporation":                        {"CORP"},
-		"the-council":                            {cnc},
-		"the-courier-association":                {tca},
-		"the-cutting-edge":                       {"TCE"},
-		"the-cracking-lords":                     {"TCL"},
-		"the-damned-souls":                       {"TDS"},
-		"the-dark-sector-courier-association":    {"TDSCA"},
-		"the-defective-detectives":               {"TDD"},
-		"the-dirty-dozen":                        {"TDD"},
-		"the-dream-team":                         {"TDT"},
-		"the-documentation-network":              {"TDN"},
-		"the-dominators":                         {"DOM", "Dominators"},
-		"the-elementals-piratelist":              {"TEP"},
-		"the-elite-scripting-team":               {"TEST"},
-		"the-entertainment-team":                 {"TET"},
-		"the-elite-crew":                         {"TEC"},
-		"the-faction":                            {"FACTION"},
-		"the-firm":                               {"FiRM", "FRM"},
-		"the-force-team":                         {"TFT"},
-		"the-federation-of-software-theft":       {"FOST"},
-		"the-fuck-you-crew":                      {"TFUC"},
-		"the-gameboy-charts":                     {"GCharts"},
-		"the-gamers-edge":                        {"TGE"},
-		"the-game-review":                        {"TGR"},
-		"the-game-scene-chart":                   {"TGSC"},
-		"the-guild-of-thieves":                   {"TGT"},
-		"the-grand-council":                      {"TGC", "Grand Council"},
-		"the-hardened-criminals":                 {"THC"},
-		"the-hard-hackers":                       {"THH"},
-		"the-hammer":                             {"MIR"},
-		"the-hard-wares":                         {"THW"},
-		"the-hill-people":                        {"THP"},
-		"the-humble-guys":                        {"THG", "Humble"},
-		"the-kiwi-killers":                       {"TKK"},
-		"the-illinois-pirates":                   {"TIP"},
-		"the-internet-dream-team":                {"TiDT"},
-		"the-lamerz-group":                       {"TLG"},
-		"the-inner-circle":                       {"TIC"},
-		"the-lightning-crew":                     {"TLC"},
-		"the-mappers-guild":                      {"TMG"},
-		"the-missing-link":                       {"TML"},
-		"the-millennium-group":                   {"TMG"},
-		"the-mental-midgets":                     {"TMM"},
-		"the-nameless-ones":                      {"TNO"},
-		"the-naked-truth-magazine":               {"NTM"},
-		"the-new-order-of-sacro_elite":           {"NOOSE"},
+		"infinity-93": {alias(inf)},
+		"foundation":  {initial("FDN")},
+		"thhg": {
+			alias("The Hugo Husten Group"), alias("The Horrible Hackers from Germany"),
+		},
+		"2000ad":                                {initial("2KAD"), alias("2000 AD")},
+		"8088-state":                            {alias("8088")},
+		"aces-of-ansi-art":                      {initial("AAA")},
+		"acid-productions":                      {alias("ACiD"), alias("ANSi Creators in Demand")},
+		"addiction-in-releasing":                {initial("AiR")},
+		"advanced-art-of-cracking-group":        {initial("AAOCG")},
+		"advanced-pirate-technology":            {initial("APT")},
+		"advanced-software-accessories":         {initial("ASA"), alias("Advantage Software Accessories")},
+		"adrenalin":                             {alias("Adren")},
+		"affinity":                              {initial("AFT")},
+		"aegis-corp":                            {initial(ags)},
+		"air":                                   {alias("Team AiR"), initial("AiRISO")},
+		"arkham":                                {initial("AKM")},
+		"alpha-flight":                          {initial("AFL")},
+		"ages":                                  {initial(ags)},
+		"against-software-protection":           {initial("ASP")},
+		"aggression":                            {initial("ARN"), initial(ags)},
+		"amnesia":                               {alias("AMN")},
+		"american-pirate-industries":            {initial("API")},
+		"amplified-music-pirates":               {alias("AMP")},
+		"anemia":                                {initial("ANM")},
+		"ansi-requires-talent":                  {initial("ART")},
+		"anti-warez-association":                {initial("AWA")},
+		"anthrox":                               {initial("ATX"), initial("AS")},
+		"anti-security-agency":                  {initial("ASA")},
+		"anti-lamers-foundation":                {initial("ALF")},
+		"anarchy-international-production":      {initial("AIP")},
+		"ansi-factory":                          {initial("AFC")},
+		assign:                                  {acronym("ASSiGN")},
+		"association-of-software-conspiracy":    {initial("ASC")},
+		"astalavista-group":                     {alias("ASTA")},
+		"arab-team-4-reverse-engineering":       {initial("AT4RE")},
+		"arrogant-couriers-with-essays":         {acronym(ace)},
+		"art-of-reverse-engineering":            {initial("AORE")},
+		"artists-in-revolt":                     {initial("AiR")},
+		"argies-courier-united":                 {initial("ACU")},
+		"atari-pirates-incorporated":            {initial("API")},
+		"atlantic-trading-alliance":             {initial("ATA")},
+		"attack-decay-sustain-release":          {initial("ADSR"), initial("AR")},
+		"artists-without-loyality":              {acronym("AWoL")},
+		"arcane-corporate-elite":                {acronym(ace)},
+		"backlash":                              {initial("BLH")},
+		"bad-ass-dudes":                         {alias("BAD")},
+		"bad-news":                              {alias("BaD"), alias("B.A.D. Newsletter"), alias("The BAD News")},
+		"baywatch":                              {initial("BWH")},
+		"bentley-sidwell-productions":           {initial("BSP")},
+		"belly-are-kiss-attack":                 {acronym("BAKA")},
+		"beverly-hills-boys":                    {initial("BHB")},
+		"blades-of-steel":                       {initial("BOS"), alias("Blades")},
+		"black-out":                             {acronym("BLOT")},
+		"black-riders":                          {initial("BRD")},
+		"black-squadron":                        {initial("BS")},
+		"blaze":                                 {initial("BLZ")},
+		"blizzard":                              {initial("BLZ"), alias("blizz")},
+		"blue-beta-3d":                          {initial("BB3D")},
+		"bitchin-ansi-design":                   {initial("BAD")},
+		"binaries":                              {alias("BiN")},
+		"billionaire-boys-club":                 {initial("BBC")},
+		"break-the-copyright":                   {initial("BTCR")},
+		"brotherhood-of-warez":                  {initial("BOW")},
+		"brotherhood-union":                     {initial("BHU")},
+		"boys-from-company-c":                   {initial("BCC")},
+		"bonzai":                                {initial("BNZ")},
+		"buds-biased-utils-report":              {alias("utils")},
+		"bytegarden":                            {initial("BTG")},
+		"canadian-pirates-inc":                  {initial("CPI")},
+		"cancer":                                {initial(cnc)},
+		"car-e-lee":                             {initial("CEL")},
+		"cascada":                               {initial("CDA")},
+		"cardinals":                             {initial("CDS")},
+		"cd-images-for-the-elite":               {acronym("CiFE")},
+		"celerity-utilities-division":           {initial("CUD")},
+		crue:                                    {initial("CRUE")},
+		"chaos":                                 {initial("CHS")},
+		"class":                                 {initial(cls)},
+		"classic":                               {initial(cls)},
+		"classic-cracking-corporation":          {initial("CCC")},
+		"club-elan":                             {initial("CE"), alias("Club Elán")},
+		"chaos-cyber-creations":                 {initial("CCC")},
+		"chemical-reaction":                     {initial("CRO")},
+		"chinese-software-distribution-network": {initial("CSDN")},
+		"codex":                                 {initial("CDX")},
+		"coders-task-force":                     {initial("CTF")},
+		"coolphat-vibez":                        {initial("CPHV")},
+		"coop":                                  {alias("The Dream Team + Tristar + Red Sector Inc."), alias("The Co-op")},
+		"community-of-moral-advancement":        {acronym("COMA"), initial("CMA")},
+		"conspiracy":                            {initial("CSY"), initial("CPY")},
+		"contour":                               {initial("CTR")},
+		"console-supply-iso":                    {initial("CSISO")},
+		"console-gaming-informers":              {initial("CGI")},
+		"copycats-inc":                          {initial("CCI")},
+		"copyright-infiltration-agency":         {initial(cia)},
+		"corruption":                            {alias("COR")},
+		"corporate-graphics":                    {initial("CGX")},
+		"corrupted-programming-international":   {initial("CPI")},
+		"criminals-of-radical-extremes":         {acronym("CORE")},
+		core:                                    {acronym("CORE")},
+		cpc2001:                                 {alias("C.P.C. 2001"), initial("CPC"), alias("CPC 2001")},
+		"couriers-of-pirated-software":          {acronym("COPS")},
+		"couriers-of-proven-software":           {acronym("COPS")},
+		"courier-weektop-scorecard":             {initial("CWS")},
+		"couriers-of-darkness":                  {initial("COD")},
+		"crack-report-weekly":                   {initial("CRW")},
+		"cowboys-from-hell":                     {initial("CFH")},
+		"crackers-and-hackers-anonymous":        {initial("CHA")},
+		"crackers-in-action":                    {initial(cia)},
+		"crackers-international-alliance":       {initial(cia), alias("Crackers Int'l Alliance")},
+		"crackpl":                               {initial("CP")},
+		"crack-in-morocco":                      {initial("CiM")},
+		"cracking-for-fun":                      {initial("CFF")},
+		"cracking-101":                          {initial("C101")},
+		"cracking-in-ocean":                     {initial("CiO")},
+		"crackers-ampersand-whackers":           {initial("C&W"), initial("CW")},
+		"crazyworld":                            {initial("CZW")},
+		"creeping-death-software":               {initial("CDS")},
+		"crime-syndicate-net":                   {initial("TCS")},
+		"crystal-phasematics":                   {initial("CPM")},
+		"cryptonics-crew":                       {initial("CTC")},
+		"texas-chainsaw-massacre-bbs":           {initial("TCM BBS")},
+		"what-the-bbs":                          {alias("What The..?! BBS")},
+		"creators-of-intense-art":               {initial(cia)},
+		"covert-action-ii-bbs":                  {alias("Covert Action 2 BBS"), initial("CA2 BBS")},
+		"creators-of-revolutionary-pictures":    {acronym("CORP"), alias("CφRP"), initial("CRP"), acronym("C.O.R.P")},
+		"crude":                                 {initial("CRD")},
+		"cti":                                   {alias("Crude & TDK iNC")},
+		"cybrix":                                {initial("CBX"), alias("Cybrix Couriering")},
+		"cygnus":                                {alias("CYG")},
+		"cybermail":                             {initial("CM")},
+		"cyber-force":                           {initial("CF")},
+		"cyber-legion":                          {initial("CL")},
+		"cybercrime-international-network":      {initial("CCi"), alias("CyberCrime Inc.")},
+		"darksiders":                            {initial("DS")},
+		"da-breaker-crew":                       {initial("DBC")},
+		"dbcdemo":                               {alias("DBC"), alias("Da Breaker Crew Demo Division")},
+		"dagger":                                {initial("DGR")},
+		"damage-incorporated":                   {initial("Di")},
+		"damn-excellent-ansi-design":            {initial("DeAD")},
+		"damn-excellent-ansi-designers":         {initial("DeAD")}, // Correct
+		"darkside-incorporated":                 {initial("DSI")},
+		"dark-towers-international":             {initial("DTI")},
+		"delirium-tremens-group":                {initial("DTG")},
+		"dead-memory":                           {initial("DM")},
+		"dead-on-arrival":                       {initial(doa)},
+		"dead-pirates-society":                  {initial("DPS")},
+		"decrepit-old-geezers":                  {initial("DOG")},
+		"defacto":                               {initial("DF")},
+		"defacto2":                              {initial("DF2"), initial("DF")},
+		"defacto2net":                           {initial("DF2")},
+		"defiant":                               {initial("DFT")},
+		"demented-dimensions":                   {initial("DD")},
+		"delirium-of-disorder":                  {initial("DoD").note("also the initialism of Drink or Die")},
+		"demon-release-crew":                    {initial("DRC")},
+		"desperate-turk-crackers":               {alias("Desperate"), initial("DP")},
+		"destined-masters-of-zines":             {initial("DMZ")},
+		"digital-factory":                       {initial("DF")},
+		"disciples-of-private-enterprise":       {acronym("DOPE")},
+		"disciples-of-the-dark-knight":          {initial("DDK")},
+		"disire":                                {initial("DSR")},
+		"dead-weight":                           {initial("DW")},
+		"dead-or-alive":                         {initial(doa)},
+		"deviance":                              {alias("DEV"), initial("DVN"), initial("DVNiSO")},
+		"divine": {
+			initial("DVN"), alias("Divine Gods"), alias("Divine ISO"), initial("DVNISO"),
+			alias("DIVINEISO"),
+		},
+		"dinobytes":                             {alias("DiNO-BYTES"), alias("Dino Bytes")},
+		"cadmium":                               {initial("cdm")},
+		"phxiso":                                {alias("Phoenix ISO")},
+		"details":                               {initial("DTS")},
+		"the-kennal-club":                       {initial("TKC"), initial("KC")},
+		"deviated":                              {initial("DVT")},
+		"devotion":                              {alias("DEV"), alias("devot")},
+		"digerati":                              {initial("DGT")},
+		"digital-artists-of-the-rare-kind":      {acronym("DARK")},
+		"digital-insanity":                      {initial("DI")},
+		"digital-millennium-cracking-alliance":  {initial("DMCA")},
+		"digital-noise-alliance":                {initial("DNA")},
+		"direct-from-stars":                     {initial("DFS")},
+		"direction-simple-la-kamisole":          {initial("DSK")},
+		"dimension":                             {initial("DMS")},
+		"distinct":                              {initial(dtc), initial("DTN")},
+		"dislocated-babes":                      {initial(dst)},
+		"disassemblers-of-america":              {initial(doa)},
+		"distributors-of-classic-warez":         {initial("DCW")},
+		"divide-by-zero":                        {initial("DBZ")},
+		"domination-in-couriering":              {initial("DiC")},
+		"downtown-hackers-crew":                 {alias("D.H Crew"), initial("DHC")},
+		"dreadloc":                              {initial("DLC")},
+		"dream-team":                            {initial("DT")},
+		"dream-syndicate":                       {initial("DS")},
+		"drunken-rom-group":                     {initial("DRG"), alias("Drunken")},
+		"drone":                                 {initial("DRN")},
+		"drink-or-die":                          {initial("DOD").note("also the initialism of Delirium of Disorder")},
+		"dvt":                                   {alias("Devotion"), alias("TeamDVT")},
+		"deadly-underground-network-of-elites":  {acronym("DUNE")},
+		"dutch-computer-enterprise":             {initial("DCE")},
+		"doc-writers-inc":                       {initial("DWI")},
+		"dynasty":                               {alias("DYN"), initial("DNS")},
+		"same-shit-different-day":               {initial("SS-DD")},
+		"dynamix":                               {initial("DNX")},
+		"dytec":                                 {alias("DYT"), initial(dtc)},
+		"eagle-soft-incorporated":               {initial("ESI")},
+		"ebola-virus-crew":                      {initial("EVC")},
+		"eclipse":                               {alias("ECL")},
+		"east-coast-connection":                 {initial("ECC")},
+		"eximius":                               {initial("XMS")},
+		"extasy":                                {initial("EX")},
+		"exodus-couriers":                       {alias("Exodus Couriering")},
+		"elite-carding-network":                 {initial("ECN")},
+		"empire-of-darkness":                    {initial("EOD")},
+		"embrace":                               {alias("EMB")},
+		"electro-magnetic-crackers":             {initial("EMC")},
+		"electromotive-force":                   {initial("EMF")},
+		"electronic-rats":                       {initial("ECR")},
+		"empire":                                {alias("EMP")},
+		"emporio":                               {alias("EMP")},
+		"end-of-file":                           {initial("EOF")},
+		"energy":                                {initial("NRG")},
+		epix:                                    {acronym("EPIX")},
+		"equinox":                               {initial("EQX")},
+		"esprit-couriers":                       {alias("Esprit")},
+		"esp-pirates":                           {alias("ESP")},
+		"eternity":                              {alias("ETE")},
+		"eithel":                                {initial("ETH")},
+		"excess":                                {initial("ECS")},
+		"exceptional":                           {initial("XCP")},
+		"excessive-force-crew":                  {initial("EFC")},
+		"explosion":                             {initial("EPN")},
+		"extinct":                               {initial("EX")},
+		"extreme":                               {alias("EXT")},
+		"extreme-team":                          {initial("ET")},
+		"extreme-graphix-alliance":              {initial("XGA")},
+		"exterminators":                         {initial("TEX")},
+		"extreme-trading-crew":                  {initial("ETC")},
+		"evidence":                              {initial("EVD")},
+		"euphoria":                              {initial("EPH")},
+		"fairlight":                             {initial("FLT")},
+		"fairlight-dox":                         {initial("FDX"), initial("FLTDOX"), initial("FAIRDOX")},
+		"faith":                                 {initial("FTH")},
+		"fallen":                                {initial("FLN")},
+		"fatal":                                 {initial("FTL")},
+		"fatbastards":                           {initial("FB")},
+		"fantastic-4-cracking-group":            {initial("F4CG")},
+		"fast-action-trading-elite":             {acronym("fATE")},
+		"faster-than-light-couriers":            {initial("FLC")},
+		"fatigued-couriers-network":             {initial("FCN")},
+		"federal-cracking-consortium":           {initial("FCC")},
+		"fast-elite-distributors-of-software":   {acronym("FEDS")},
+		"fighting-for-fun":                      {initial("fff")},
+		"fight-only-for-freedom":                {initial("FOFF")},
+		"file-rappers":                          {initial("FR")},
+		"fistful-of-steel":                      {initial("FOS")},
+		"five-o":                                {alias("Five 0")},
+		"flying-horse-cracking-force":           {initial("FHCF")},
+		"free-on-the-line":                      {acronym("FOTL")},
+		"freelancers-guild":                     {initial("FRL")},
+		"fucked-beyond-repair":                  {initial("FBR")},
+		"future-crew":                           {initial("FC")},
+		"future-brain-inc":                      {initial("FBi"), initial("FBIA")},
+		"futuristic-artists-with-talent":        {initial("FAT")},
+		"fusion":                                {initial("FSN")},
+		"galactic-review":                       {alias("GALA")},
+		"game-release-list":                     {alias("Releases by Claude Rains")},
+		"grave-yard-crew":                       {initial("GYC")},
+		"gencliq":                               {initial("GCT")},
+		"german-cracking-group":                 {initial("GCG")},
+		"german-consoles-syndicate":             {initial("GCS")},
+		"german-trading-alliance":               {initial("GTA")},
+		"german-warez-alliance":                 {initial("GWA")},
+		"genesis-ppe":                           {initial("GNS")},
+		"genesis":                               {initial("GNS")},
+		"genesis-project":                       {initial("GP")},
+		"guild-of-distributors":                 {acronym("GODS"), initial("GDS")},
+		"glory":                                 {alias("Glory Couriers"), initial("GL")},
+		"god-damn-warez":                        {initial("GDW")},
+		"gorgeous-ladies-of-warez":              {acronym("GLOW"), alias("GlowISO")},
+		"hrps":                                  {alias("H.R. Puppystuff")},
+		"the-free-loaders":                      {initial("TFL")},
+		"heritage":                              {initial("HTG")},
+		"gothic":                                {initial("GTHC")},
+		"goofy-illitape-softwarez":              {initial("GIS")},
+		"graphic-revolution-in-progress":        {acronym("GRiP")},
+		"grand-old-pirates":                     {initial("GOP")},
+		"elite-couriers-group":                  {initial("ECG")},
+		"epsilon":                               {alias("EPS")},
+		"fatal-connection":                      {initial("FC"), alias("FATAL")},
+		"fawkes":                                {initial("FWK")},
+		"fasiso":                                {alias("FAS")},
+		"file-propulsion-system":                {initial("FPS")},
+		"friendship":                            {initial("FRD"), alias("Friends")},
+		"federation-of-free-traders":            {initial("FOFT")},
+		"fyllecell":                             {initial("FLC")},
+		"future-ansi-creations":                 {initial("FAiC")},
+		"graphically-enhanced-magazine":         {initial("GEM")},
+		"glorious-console-master-race":          {initial("GCMR")},
+		"ghost-riders":                          {initial("GRS")},
+		"god-of-war":                            {initial("GOW")},
+		"graphics-rendered-in-magnificence":     {acronym("GRiM")},
+		"gainseville-pirates-association":       {initial("GPA")},
+		"hard-to-beat-team":                     {initial("HTB")},
+		"hard-core-hackers":                     {initial("hCh")},
+		"halcyon":                               {initial("HLN")},
+		"hackers-with-attitude":                 {initial("HWA")},
+		hate:                                    {acronym("HaTe")},
+		"hearts-in-the-shadows":                 {acronym("HiTS")},
+		"hardcore-elite-mother-phuckers":        {acronym("HEMP"), initial("HMP")},
+		"haze":                                  {initial("HZ")},
+		"highroad":                              {initial("HR")},
+		"high-society":                          {initial("HS")},
+		"high-voltage":                          {initial("HV"), initial("HVC"), alias("VOLT")},
+		"high-speed-couriers":                   {initial("HSC")},
+		"high-tech-couriers":                    {initial("HTC")},
+		"high-speed-global-mass-trading":        {initial("HSGMT")},
+		"hipe":                                  {initial("HPE")},
+		"hoodlum":                               {initial("HLM")},
+		"hooligans":                             {initial("HLG")},
+		"horizon":                               {initial("HZN")},
+		"humble-dox":                            {alias("The Humble Guys DOX")},
+		"hummers":                               {alias("HUM")},
+		"hybrid":                                {initial("HBD")},
+		"gobble":                                {alias("GOB")},
+		"grind":                                 {initial("GND")},
+		"imperial-falcon":                       {initial("IF")},
+		"the-unorginal-bastards":                {initial("TUB")},
+		"hysteria":                              {initial("HSA")},
+		"hype":                                  {alias("HYP")},
+		"keen-like-frogs":                       {initial("KLF")},
+		"kryptonic-hacking-team":                {initial("KHT")},
+		"kalisto":                               {alias("KAL")},
+		"krackass":                              {alias("KASS")},
+		"katharsis":                             {initial("KTS")},
+		"kosmic-loader-foundation":              {initial("KLF")},
+		"knights-of-the-round-table":            {acronym("KORT")},
+		"kyrie-eleison":                         {initial("KE"), initial("KEISO")},
+		"kryn":                                  {initial("KRN")},
+		"idiots-creations-unlimited":            {initial("iCU")},
+		"illuminatus":                           {alias("ILL")},
+		"illusion":                              {alias("iLL")},
+		"infinity-e_mag":                        {alias(inf)},
+		"ifranian-rebellious-hackers":           {initial("IRH")},
+		iirg:                                    {initial("IIRG")},
+		"immersion":                             {initial("IMS")},
+		"independent":                           {alias("IND"), alias("individual")},
+		"independent-crackers-union":            {initial("ICU")},
+		"independant-cracking-institute":        {initial("ICI"), alias("Independent Cracking Institute")}, //nolint:misspell
+		"indonesia-reversing-crew":              {initial("IRC")},
+		"intension":                             {initial("ITN")},
+		"international-ghost-hunters":           {initial("IGH")},
+		"international-network-of-crackers":     {initial("INC")},
+		"inc-europe":                            {alias("INC"), alias(inc)},
+		"international-cracking-crew":           {initial("iCC")},
+		"inc-documentation-division":            {initial("IDD"), alias(inc)},
+		"inc-utility-division":                  {initial("IUD"), alias(inc)},
+		"interpol":                              {initial("IPL")},
+		"image":                                 {initial("IMG")},
+		"imphobia":                              {alias("IMP")},
+		"impact":                                {alias("IMP")},
+		"influence":                             {alias("iNF")},
+		"infinity":                              {alias(inf)},
+		"infinity-trainers-unlimited":           {initial("ITU")},
+		"information-liberation-league":         {initial("ILL")},
+		"international-software-traders":        {initial("IST")},
+		"insanity":                              {alias("Insan")},
+		"inquisition":                           {alias("INQ")},
+		"interceptor":                           {alias("INT")},
+		"invisible":                             {alias("INV")},
+		"iranian-crackers-association":          {initial("ICA")},
+		"iridium-magazine":                      {alias("IRIDI")},
+		"jrp":                                   {alias("JRP")},
+		jest:                                    {acronym("JEST")},
+		"justiso":                               {initial("JI")},
+		"just-for-fun":                          {initial("JFF"), initial("J4F"), alias("Just 4 Fun")},
+		"just-for-phun":                         {initial("J4P")},
+		"just-the-facts":                        {initial("JTF")},
+		"lamers-of-power":                       {initial("LOP")},
+		"lancelot":                              {alias("LANCE")},
+		"lancelot-2":                            {alias("LANCE")},
+		"llange-art-reptareeko":                 {initial("LAR")},
+		"last-poets-society":                    {initial("LPS")},
+		"laxity":                                {initial("LXT")},
+		"lethal-software-distributors":          {initial("LSD")},
+		"legacy":                                {initial("LGC"), initial("LGY")},
+		"legend":                                {initial("LGD"), alias("Legend PC"), alias("PC/Legend")},
+		"legends-never-die":                     {initial("LND")},
+		"legion-of-doom":                        {initial("LOD"), initial("LOH"), alias("LOD/H")},
+		"legion-of-dynamic-discord":             {initial("LODD")},
+		"legion-of-rising-distributors":         {acronym("LORD")},
+		"inferno":                               {alias(inf)},
+		"licensed-to-draw":                      {initial("LTD")},
+		"little-big-one":                        {initial("LBO")},
+		"light-speed-distributors":              {initial("LSD")},
+		"light-speed-warez":                     {initial("LSW")},
+		"lightforce":                            {initial("LFC"), initial("LF")},
+		"lithium":                               {alias("LIT")},
+		"linezer0":                              {initial("Lz0"), alias("Linezero")},
+		"live-now-die-later":                    {initial("LnDL")},
+		"lockless":                              {initial("LKL")},
+		"legion-of-the-etherial":                {acronym("LOTE")},
+		"local-courier-system":                  {initial("LCS")},
+		"living-organisms-on-mars":              {initial("LOOM")},
+		"los-angeles-sysops-alliance":           {acronym("LASA")},
+		"lucid":                                 {initial("LCD")},
+		"lucifer":                               {alias("LUC")},
+		"mack-crack-corporation":                {initial("MCC")},
+		"masters-of-abstractions-and-illusions": {initial("MAi")},
+		"malicious-art-denomination":            {acronym(mad)},
+		"malondorous-griffin-entanglement":      {initial("MGE")},
+		"malice":                                {alias("MAL")},
+		"majic-12":                              {initial("M12")},
+		"magnificent-art-designers":             {acronym(mad)},
+		mash:                                    {acronym("MASH")},
+		"masters-of-the-art-experience":         {initial("MAX")},
+		"masque":                                {initial("MSQ")},
+		"master-artists-guild-for-the-elite":    {acronym("MAGE")},
+		"mea-culpa":                             {initial("MC")},
+		"medeival-emporium-of-warez":            {initial("MEOW")}, //nolint:misspell
+		"menaceiisociety":                       {initial("MiiS")},
+		"millenium":                             {initial("MnM"), initial("MiLLENNiUM")}, //nolint:misspell
+		"mutual-assured-destruction":            {acronym(mad)},
+		"manifest":                              {initial("MFD"), alias("Manifest Destiny")},
+		mgsci:                                   {initial("MGSCI")},
+		"mercury":                               {alias("MERC")},
+		"more-stupid-initials":                  {initial("MSI")},
+		"motiv8":                                {initial("M8")},
+		"micropirates-inc":                      {initial("MPI")},
+		"mickey-mouse-club":                     {initial("MMC")},
+		"mirage":                                {alias("MIR").note("also the initialism of The Hammer")},
+		"miami-cracking-machine":                {initial("MCM")},
+		"mindcrash":                             {initial("MC")},
+		"modders-on-drugs":                      {alias("MOD")},
+		"myth-inc":                              {alias("M.Y.T.H. Inc"), alias("MYTH Inc Link BBS")},
+		"myth*deviance":                         {initial("MDVN")},
+		"myth":                                  {alias("MYT")},
+		"nah-kolor":                             {alias("NAH")},
+		neua:                                    {initial("NEUA"), alias("North Eastern Underground Alliance")},
+		nuaa:                                    {initial("NUAA")},
+		"napalm":                                {initial("NPM")},
+		"national-crackers-alliance":            {initial("NCA")},
+		"national-distribution-network":         {initial("NDN")},
+		nnan:                                    {initial("NNAN")},
+		"nerve":                                 {initial("NRV")},
+		"netrunners":                            {initial("NR")},
+		"network-software-association":          {initial("NSA")},
+		"new-york-crackers":                     {initial("NYC")},
+		"new-world-order":                       {initial("NWO")},
+		"nexus":                                 {initial("NXS"), initial("NX")},
+		"nemesis":                               {initial("NMS")},
+		"nokturnal-trading-alliance":            {initial("NTA")},
+		"no-fear":                               {initial("NF")},
+		"noobs-reverser-team":                   {initial("NBR")},
+		"nordic-engineering-corporation":        {initial("NEC")},
+		"north-eastern-crackers":                {initial("NEC")},
+		nappa:                                   {acronym("NAPPA"), alias("NAP/PA"), acronym("NAPE")},
+		"nintendo-backup-crew":                  {initial("NBC")},
+		"nrp":                                   {alias("NRP")},
+		nasa:                                    {acronym("NASA")},
+		"nc_17":                                 {alias("NC")},
+		"national-software-network":             {initial("NSN")},
+		"norwegian-cracking-company":            {initial("NCC")},
+		"noclass":                               {initial("NoCLS"), initial(cls)},
+		"not-productions":                       {alias("NOT!")},
+		"objectile":                             {initial("OCT")},
+		"oceanine":                              {initial("OCN")},
+		"oddity":                                {initial("ODT")},
+		"old-warez-inc":                         {initial("OWI"), alias("OldWarez Inc")},
+		"oldskool":                              {initial("OS")},
+		"old-school-pirates":                    {initial("OSP")},
+		"oneup":                                 {initial("1UP"), alias("One Up")},
+		"on_line-revenge":                       {initial("OLR"), alias("Online Revenge")},
+		"orion":                                 {initial("ORN")},
+		"origin":                                {initial("OGN")},
+		"originally-funny-guys":                 {initial("OFG")},
+		"our-nefarious-endeavor":                {initial("ONE")},
+		"out-rage-pirates":                      {initial("ORP")},
+		"outcast":                               {alias("OUT")},
+		"outlaws":                               {initial("OTL"), alias("OUT")},
+		"overkill":                              {initial("OVL")},
+		"p2psaurus":                             {initial("PS")},
+		"pirates-against-purchasing-software":   {acronym("PAPS")},
+		"partners-in-crime":                     {initial("PiC")},
+		"paradigm":                              {initial("PDM"), alias("Zeus"), initial("PDMISO"), alias("Paradigm ISO")},
+		"paradox":                               {initial("PDX")},
+		"pc_cracking-service":                   {initial("PC-CS"), initial("PCCS")},
+		"pe*trsi*tdt":                           {alias("Public Enemy + Tristar + Red Sector + The Dream Team")},
+		"pentagram":                             {initial("PTG")},
+		"pentium-force-team":                    {initial("PFT")},
+		"persian-genius-team":                   {initial("PGteam")},
+		"phoenix":                               {initial("PHX")},
+		"phreakerz-against-commerce":            {initial("PAC")},
+		"pirates-club-inc":                      {alias("PC INC")},
+		"phrozen-crew":                          {initial("PC")},
+		"pirates-cove":                          {initial("PC")},
+		"pirates-analyze-warez":                 {initial("PAW")},
+		"pirates-gone-crazy":                    {initial("PGC")},
+		"pirates-in-legion":                     {initial("PiL")},
+		"pirates-sick-of-initials":              {initial("PSi")},
+		"pirates-with-attitudes":                {initial("PWA")},
+		"pirates-releasing-in-mass-extremes":    {acronym("PRiME")},
+		"pizza-dox":                             {alias("PizzaDOX")},
+		"plate-steel-productions":               {initial("PSP")},
+		"police":                                {initial("PLC")},
+		"power-crisis-international":            {initial("PCI")},
+		"portable-apps-crew-europe":             {acronym("PACE")},
+		"psycho-corporate-productions":          {initial("PCP")},
+		"predator-666":                          {initial("PRD666")},
+		"primal":                                {initial("PML")},
+		"prophecy":                              {initial("PCY")},
+		"propaganda":                            {alias("PROP")},
+		"prozac":                                {alias("PRO")},
+		"ptl-club":                              {alias("PTL")},
+		"postmortem":                            {initial("PM")},
+		"prestige":                              {initial("PSG"), initial("PST")},
+		"protection-fucking-sucks":              {initial("PFS")},
+		"psycho-squad":                          {initial("PSD")},
+		"public-brand-software":                 {initial("PBS")},
+		"public-enemy":                          {initial("PE")},
+		"pyradical":                             {alias("PYR")},
+		"quartex":                               {initial("QTX"), alias("Quartex PC")},
+		quick:                                   {initial("QUICK")},
+		"radical-elite-movement":                {initial("REM")},
+		"really-awful-music":                    {initial("RAM")},
+		"rage":                                  {alias("Rage'94")},
+		"razordox": {
+			initial(rzr), alias(razor), alias("Razor DOX"),
+			alias("Razor 1911 Documentation Division"),
+		},
+		"razor-1911":                          {initial(rzr), alias(razor), alias("Razor CD"), alias("Razor CD Division")},
+		"razor-1911-demo":                     {initial(rzr), alias(razor)},
+		"real-life-then-scene":                {initial("RLTS")},
+		"real-crazy-artists":                  {initial("RCA")},
+		"real-cocoheads":                      {initial("RC")},
+		"reality-check-network":               {initial("RCN")},
+		"real-time-pirates":                   {initial("RTP")},
+		"rebels":                              {initial("RBS")},
+		"r2":                                  {alias("R2"), initial("RTWO")},
+		"recoil":                              {initial("RCL")},
+		"red-sector-inc":                      {initial("RSI")},
+		"red-green-blue":                      {initial("RGB")},
+		"release-on-rampage":                  {initial("RoR")},
+		"relic":                               {alias("REL")},
+		"reloaded":                            {initial("RLD")},
+		"relativity":                          {initial("REV")},
+		"reflux":                              {initial("RLX")},
+		"relentless-pursuit-of-magnificence":  {initial("RPM"), alias("Relentlessly Pursuing Magnificence")},
+		"republic-banana":                     {initial("RB")},
+		"request-to-send":                     {initial("RTS")},
+		"renaissance":                         {initial("RNS"), initial("RSS")},
+		"reign-of-terror":                     {initial("RoT")},
+		"resistance-is-futile":                {initial("RiF")},
+		"rescue-raider":                       {initial("RR"), alias("RR INC"), initial("TDI")},
+		"resurrection":                        {initial("RSR"), alias("RES")},
+		"revelation":                          {initial("RVL")},
+		"review-of-aquired-warez":             {initial("RAW")}, //nolint:misspell
+		"revenge-crew":                        {alias("REV")},
+		"revolution-project":                  {initial("RP")},
+		"reverse-2-revolutionize":             {initial("R2R")},
+		"reverse-engineers-dream":             {initial("RED")},
+		"reverse-engineering-in-software":     {initial("REiS")},
+		"reverse-engineering-passion-team":    {acronym("REPT")},
+		"reviving-intelligent-fast-trading":   {acronym("RiFT")},
+		"revolution":                          {initial("RVL"), initial("RTN")},
+		rampage:                               {acronym("RAMPAGE")},
+		"rise-in-superior-couriering":         {acronym("RiSC")},
+		"risciso":                             {alias("RiSC")},
+		"rom-1911":                            {alias("Razor 1911 CD-ROM Division")},
+		"romkids":                             {initial("RMK")},
+		"russian-trading-alliance":            {initial("RTA")},
+		"sanxion":                             {initial("SXN")},
+		"saints-and-sinners-group":            {initial("SSG")},
+		"seek-n-destroy":                      {initial("SND")},
+		"scene-charts":                        {initial("SC")},
+		"skid-row":                            {initial("SR"), alias("Skidrow")},
+		"scoopex":                             {initial("SCX"), initial("SPX")},
+		"scooby-snack-magazine":               {initial("SSM")},
+		"scandal":                             {initial("SCL")},
+		scud:                                  {acronym("SCUD")},
+		"scenenotice":                         {initial("SCN")},
+		"scienide":                            {alias("SCi")},
+		"sea-shell-commando":                  {initial("SSC")},
+		"serials-2000":                        {initial("S2K")},
+		"software-exchange":                   {initial("SEX"), alias("SOFT-EX")},
+		"silicon-dream-artists":               {initial("SDA")},
+		"shallow-grounds":                     {initial("SG")},
+		"share-and-enjoy":                     {initial("SAE")},
+		"shore-cracking-ansi-runners":         {acronym("SCaR")},
+		"shmeitcorp":                          {alias("Shmeit Corp"), initial("SC")},
+		"shitonlygerman":                      {initial("SOG"), alias("Scheisse Deutsch Only")},
+		"skillion":                            {initial("SKN")},
+		"shiver":                              {initial("SHV")},
+		"siege":                               {initial("SG")},
+		"silent-cracking-force":               {initial("SCF")},
+		"silent-cracking-service":             {initial("SCS")},
+		"sinister":                            {alias("SiN")},
+		"slaves-of-pain":                      {initial("SoP")},
+		"smokers-in-krime":                    {initial("SiK")},
+		"smegma":                              {initial("SMG")},
+		"society-of-sharing":                  {initial("SOS")},
+		"society-of-suckers":                  {initial("SOS")},
+		"sodom":                               {initial("SDM")},
+		"solitudes":                           {initial("SLT")},
+		"some-lonesome-ansi-makers":           {acronym("SLAM")},
+		"sorcerers":                           {alias("SOR")},
+		"software-liberation-army":            {initial("SLA")},
+		"software-pirating-coalition":         {initial("SPC")},
+		"software-chronicles-digest":          {initial("SCD")},
+		"software-in-danger":                  {initial("SID")},
+		"software-pirates-inc":                {initial("SPI"), alias("Software Pirates")},
+		"south-eastern-elite":                 {initial("SEE")},
+		"spetznas":                            {alias("spetznaz")},
+		"spazm":                               {initial("SPZ"), initial("SPZM"), alias("Spazm/VGA")},
+		"spazm-couriers":                      {initial("SPZ"), initial("SPZM"), alias("SPAZM")},
+		"spectrum":                            {alias("SPEC")},
+		"standards-of-piracy-association":     {initial("SPA")},
+		"static":                              {initial("STC")},
+		"superior-art-creations":              {initial("SAC")},
+		"surprise-productions":                {initial("SP")},
+		"suicide-is-painless":                 {initial("SiP")},
+		"synapse":                             {alias("SYN")},
+		"syndicate-of-dreams":                 {initial("SOD")},
+		"sysop-support-network":               {initial("SSN")},
+		"sma-posse":                           {alias("$MA")},
+		"syndicate":                           {alias("SYN")},
+		"the-syndicate":                       {alias("$ynd"), alias("The $yndicate"), alias("The $yndacite")},
+		"talent-entertainment":                {initial("TLN")},
+		"tdu_jam":                             {alias("TDU-Jam!"), alias("TDU"), alias("TDUJAM"), alias("TDU JAM")},
+		"starlight":                           {initial("SLT")},
+		"scum":                                {alias("S.C.U.M")},
+		"crazy-nation":                        {initial("CZN")},
+		"global-piracy-foundation":            {initial("GPF")},
+		"orbital-one-three":                   {initial("O13")},
+		"secret-warez-people":                 {initial("SWP")},
+		"quantum":                             {initial("QTM")},
+		"repulsion":                           {initial("RSP")},
+		"soncrap":                             {initial("SC")},
+		"axis":                                {initial("AX")},
+		"bomb-squad":                          {initial("BS")},
+		"cryp70nym":                           {alias("CrY")},
+		"13-omens":                            {alias("13o")},
+		"national-pirate-list":                {alias("Bounty")},
+		"crime":                               {initial("CR"), initial("CRM")},
+		"countdown":                           {initial("CNT"), alias("Count Down")},
+		"skeleton-army":                       {initial("SKL"), alias("The Skeleton Army")},
+		"winterhawk-dupe-list":                {initial("Wintr"), alias("Big Bird"), alias("BigBird"), initial("BB")},
+		"dragon":                              {initial("DGN")},
+		"team-edge":                           {alias("EDGE")},
+		"team-technotrogens":                  {initial("TT3"), alias("Team T3")},
+		"technobrains":                        {initial("TCB")},
+		"terratron":                           {alias("TERR")},
+		"terror-zone-underground":             {initial("TZU")},
+		"tekno-rage-ampersand-pirasoft":       {initial("TRPS")},
+		"the-amatuer-crackist-tutorial":       {initial("ACT")}, //nolint:misspell
+		"the-artists-guild":                   {initial("TAG")},
+		"the-black-star":                      {initial("TBS")},
+		"the-bitter-end":                      {initial("TBE")},
+		"the-brain-slayer":                    {initial("TBS")},
+		"the-buyers-group":                    {initial("TBG")},
+		"the-brotherhood-of-gods-and-retards": {initial("BGR")},
+		"the-care-company":                    {initial(tcc)},
+		"the-canadian-crackers":               {initial(tcc)},
+		"the-chronic-krackers":                {initial("TCK")},
+		"the-cracking-answer":                 {initial(tca)},
+		"the-crazed-asylum":                   {initial(tca)},
+		"the-cracking-clan":                   {initial(tcc)},
+		"the-cradle-traders":                  {initial("TCT")},
+		"the-codeblasters":                    {initial("TCB")},
+		"the-console-division":                {initial("TCD")},
+		"the-corporation":                     {alias("CORP")},
+		"the-council":                         {initial(cnc)},
+		"the-courier-association":             {initial(tca)},
+		"the-cutting-edge":                    {initial("TCE")},
+		"the-cracking-lords":                  {initial("TCL")},
+		"the-damned-souls":                    {initial("TDS")},
+		"the-dark-sector-courier-association": {initial("TDSCA")},
+		"the-defective-detectives":            {initial("TDD")},
+		"the-dirty-dozen":                     {initial("TDD")},
+		"the-dream-team":                      {initial("TDT")},
+		"the-documentation-network":           {initial("TDN")},
+		"the-dominators":                      {alias("DOM"), alias("Dominators")},
+		"the-elementals-piratelist":           {initial("TEP")},
+		"the-elite-scripting-team":            {acronym("TEST")},
+		"the-entertainment-team":              {initial("TET")},
+		"the-elite-crew":                      {initial("TEC")},
+		"the-faction":                         {alias("FACTION")},
+		"the-firm":                            {alias("FiRM"), initial("FRM")},
+		"the-force-team":                      {initial("TFT")},
+		"the-federation-of-software-theft":    {acronym("FOST")},
+		"the-fuck-you-crew":                   {initial("TFUC")},
+		"the-gameboy-charts":                  {initial("GCharts")},
+		"the-gamers-edge":                     {initial("TGE")},
+		"the-game-review":                     {initial("TGR")},
+		"the-game-scene-chart":                {initial("TGSC")},
+		"the-guild-of-thieves":                {initial("TGT")},
+		"the-grand-council":                   {initial("TGC"), alias("Grand Council")},
+		"the-hardened-criminals":              {initial("THC")},
+		"the-hard-hackers":                    {initial("THH")},
+		"the-hammer":                          {initial("MIR").note("also the alias of Mirage")},
+		"the-hard-wares":                      {initial("THW")},
+		"the-hill-people":                     {initial("THP")},
+		"the-humble-guys":                     {initial("THG"), alias("Humble")},
+		"the-kiwi-killers":                    {initial("TKK")},
+		"the-illinois-pirates":                {initial("TIP")},
+		"the-internet-dream-team":             {acronym("TiDT")},
+		"the-lamerz-group":                    {initial("TLG")},
+		"the-inner-circle":                    {initial("TIC")},
+		"the-lightning-crew":                  {initial("TLC")},
+		"the-mappers-guild":                   {initial("TMG")},
+		"the-missing-link":                    {initial("TML")},
+		"the-millennium-group":                {initial("TMG")},
+		"the-mental-midgets":                  {initial("TMM")},
+		"the-nameless-ones":                   {initial("TNO")},
+		"the-naked-truth-magazine":            {initial("NTM")},
+		"the-new-order-of-sacro_elite":        {initial("NOOSE")},
 		"the-net-monkey-weekly-report": {
-			"NWR", "NetMonkey Report", "Netmonkey Weekly Report", "Netmonkey Courier Report",
-			"Netmonkey Weekend Report",
+			initial("NWR"), alias("NetMonkey Report"), alias("Netmonkey Weekly Report"), alias("Netmonkey Courier Report"),
+			alias("Netmonkey Weekend Report"),
+		},
+		"the-north-west-connection":              {initial("TNWC")},
+		"the-nova-team":                          {initial("TNT")},
+		"the-one-and-only":                       {initial("TOAO")},
+		"the-outlaws":                            {initial("TOL"), initial("OL")},
+		"the-other-side":                         {initial("TOS")},
+		"the-pirate-syndicate":                   {initial("TPS")},
+		"the-pirates-manifesto":                  {alias("Manifest")},
+		"the-pirate-world":                       {initial("TPW")},
+		"the-players-club":                       {initial(tpc)},
+		"the-phoney-coders":                      {initial(tpc)},
+		"the-phoney-coders-trainers-division":    {initial(tpc)},
+		"the-people-upstairs":                    {initial("TPU")},
+		"the-programmers-crew":                   {initial(tpc)},
+		"the-rapeware-syndicate":                 {initial("TRWS")},
+		"the-reversers-ultimate-epidemic":        {initial("tRUE")},
+		"the-reviewers-guild":                    {initial("TRG")},
+		"the-red-scorpion":                       {initial(trs)},
+		"the-reservoir-warez-report":             {alias("Report")},
+		"the-sabotage-rebellion-hackers":         {initial("TSRh")},
+		"the-safety-zone":                        {initial("TSZ")},
+		"the-shining-darkness":                   {initial("TSD")},
+		"the-space-pigs":                         {initial("SP")},
+		"the-stealth-pirate-network":             {initial("TSPN")},
+		"the-silents":                            {initial("TSL")},
+		"the-silent-terror":                      {initial("TST")},
+		"the-software-review":                    {initial("TSR")},
+		"the-software-innovation-network":        {initial("SIN")},
+		"the-sinister-syndicate":                 {initial("TSS")},
+		"the-sure-logic-syndicate":               {initial("SLS")},
+		"the-sysops-association-network":         {initial("TSAN")},
+		"the-syndicate-of-original-gangsters":    {initial("TSOG"), alias("OG SYND")},
+		"the-unbiased-dox-report":                {initial("DR")},
+		"the-underground-council":                {initial("UGC")},
+		"the-underworld-corporation":             {initial("TUC")},
+		"the-untouchables":                       {alias(unt)},
+		"the-warez-alliance":                     {initial("TWA")},
+		"trc-ware-report":                        {alias("The Ware Report!"), alias("Ware Report")},
+		"the-warez-collectors":                   {initial("TWC")},
+		"the-warez-magazine":                     {alias("The W.A.R.E.Z. Magazine")},
+		"the-week-in-warez":                      {initial("WWN")},
+		"the-wondertwins":                        {initial("TWT")},
+		"might-and-magic-bbs":                    {initial("M&M BBS")},
+		"burning-church-bbs":                     {alias("The Burnin Church BBS"), alias("The Burn'n Church BBS")},
+		"realm-of-destruction-bbs":               {alias("The Realm of Destruction BBS"), acronym("TROD BBS")},
+		"silent-tower-bbs":                       {alias("The Silent Tower BBS")},
+		"great-white-north-bbs":                  {initial("TGWN BBS"), alias("The Great White North BBS")},
+		"deep-space-9-bbs":                       {alias("Deep Space Nine BBS"), initial("DS9 BBS")},
+		"dead-zone-bbs":                          {alias("The Dead Zone BBS"), initial("TDZ BBS")},
+		"thg-fx":                                 {alias("The Humble Guys FX"), alias("THG F/X")},
+		"tkc*crackers-in-action":                 {alias("tKC"), initial("CiA")},
+		"toads":                                  {alias("T.O.A.D.S.")},
+		"toxic":                                  {alias("TOX")},
+		"terrorist-training-camp-bbs":            {initial("TCC BBS")},
+		"ecstatic-sound-production":              {initial("ESP")},
+		"two-minute-warning-bbs":                 {alias("The Two Minute Warning BBS")},
+		"armageddon-support-bbs":                 {initial("TASB"), alias("The Armageddon Support BBS"), alias("The ASB")},
+		"power-grid-bbs":                         {alias("The Powergrid BBS"), alias("Powergrid BBS"), initial("PG BBS")},
+		"trading-and-trading-international-crew": {acronym("TATiC")},
+		"tired-of-protection":                    {initial("TOP")},
+		"triad":                                  {alias("TRI")},
+		"trinity-of-triad":                       {initial("ToT")},
+		"trinity-labs-incorporated":              {initial("TLI")},
+		"tristar":                                {initial(trs)},
+		"tristar-ampersand-red-sector-inc":       {initial("TRSi"), initial(trs), alias("Tristar")},
+		tlf:                                      {initial("TLF"), alias("TRSi/Lightforce/Fusion")},
+		"twilight":                               {initial("TW")},
+		"twilight-zone":                          {initial("TZ")},
+		"twilight-designs-crew":                  {initial("TDC")},
+		"twin-sectors-inc":                       {initial("TSI")},
+		"tyranny":                                {alias("TYR"), initial("TRN")},
+		"twenty-one-twelve-bbs":                  {initial("2112"), alias("²''²")},
+		tsep:                                     {initial("TSEP")},
+		"turbo-nutter-kiwi-bastards":             {initial("TNKB"), alias("KiWi")},
+		"ultra-tech":                             {initial("UT")},
+		"ultra-tech*electro-magnetic-crackers":   {initial("UT-EMC")},
+		"unified-legendary-traders-rising-again": {initial("ULTRA")},
+		"ultra-force":                            {initial("UF"), alias("Ultraforce")},
+		"utilities-in-demand":                    {initial("UiD")},
+		"under-seh-team":                         {initial("UST")},
+		"underground-empire":                     {initial("UE")},
+		"union":                                  {alias("UNi")},
+		"united-albanian-reverse-engineers":      {initial("UARE")},
+		"united-artist-association":              {initial("UAA")},
+		"united-couriers":                        {initial("UC")},
+		"united-cracking-force":                  {initial("UCF")},
+		"universal-crackers-of-the-underground":  {initial("UCU")},
+		"united-group-international":             {initial("UGI")},
+		"united-reverse-engineering-team":        {acronym("URET")},
+		"united-software-association*fairlight": {
+			alias("USA/Fairlight"), alias("USA/FLT"),
+			initial("USA").note("United Software Association, not the country"),
 		},
-		"the-north-west-connection":              {"TNWC"},
-		"the-nova-team":                          {"TNT"},
-		"the-one-and-only":                       {"TOAO"},
-		"the-outlaws":                            {"TOL", "OL"},
-		"the-other-side":                         {"TOS"},
-		"the-pirate-syndicate":                   {"TPS"},
-		"the-pirates-manifesto":                  {"Manifest"},
-		"the-pirate-world":                       {"TPW"},
-		"the-players-club":                       {tpc},
-		"the-phoney-coders":                      {tpc},
-		"the-phoney-coders-trainers-division":    {tpc},
-		"the-people-upstairs":                    {"TPU"},
-		"the-programmers-crew":                   {tpc},
-		"the-rapeware-syndicate":                 {"TRWS"},
-		"the-reversers-ultimate-epidemic":        {"tRUE"},
-		"the-reviewers-guild":                    {"TRG"},
-		"the-red-scorpion":                       {trs},
-		"the-reservoir-warez-report":             {"Report"},
-		"the-sabotage-rebellion-hackers":         {"TSRh"},
-		"the-safety-zone":                        {"TSZ"},
-		"the-shining-darkness":                   {"TSD"},
-		"the-space-pigs":                         {"SP"},
-		"the-stealth-pirate-network":             {"TSPN"},
-		"the-silents":                            {"TSL"},
-		"the-silent-terror":                      {"TST"},
-		"the-software-review":                    {"TSR"},
-		"the-software-innovation-network":        {"SIN"},
-		"the-sinister-syndicate":                 {"TSS"},
-		"the-sure-logic-syndicate":               {"SLS"},
-		"the-sysops-association-network":         {"TSAN"},
-		"the-syndicate-of-original-gangsters":    {"TSOG", "OG SYND"},
-		"the-unbiased-dox-report":                {"DR"},
-		"the-underground-council":                {"UGC"},
-		"the-underworld-corporation":             {"TUC"},
-		"the-untouchables":                       {unt},
-		"the-warez-alliance":                     {"TWA"},
-		"trc-ware-report":                        {"The Ware Report!", "Ware Report"},
-		"the-warez-collectors":                   {"TWC"},
-		"the-warez-magazine":                     {"The W.A.R.E.Z. Magazine"},
-		"the-week-in-warez":                      {"WWN"},
-		"the-wondertwins":                        {"TWT"},
-		"might-and-magic-bbs":                    {"M&M BBS"},
-		"burning-church-bbs":                     {"The Burnin Church BBS", "The Burn'n Church BBS"},
-		"realm-of-destruction-bbs":               {"The Realm of Destruction BBS", "TROD BBS"},
-		"silent-tower-bbs":                       {"The Silent Tower BBS"},
-		"great-white-north-bbs":                  {"TGWN BBS", "The Great White North BBS"},
-		"deep-space-9-bbs":                       {"Deep Space Nine BBS", "DS9 BBS"},
-		"dead-zone-bbs":                          {"The Dead Zone BBS", "TDZ BBS"},
-		"thg-fx":                                 {"The Humble Guys FX", "THG F/X"},
-		"tkc*crackers-in-action":                 {"tKC", "CiA"},
-		"toads":                                  {"T.O.A.D.S."},
-		"toxic":                                  {"TOX"},
-		"terrorist-training-camp-bbs":            {"TCC BBS"},
-		"ecstatic-sound-production":              {"ESP"},
-		"two-minute-warning-bbs":                 {"The Two Minute Warning BBS"},
-		"armageddon-support-bbs":                 {"TASB", "The Armageddon Support BBS", "The ASB"},
-		"power-grid-bbs":                         {"The Powergrid BBS", "Powergrid BBS", "PG BBS"},
-		"trading-and-trading-international-crew": {"TATiC"},
-		"tired-of-protection":                    {"TOP"},
-		"triad":                                  {"TRI"},
-		"trinity-of-triad":                       {"ToT"},
-		"trinity-labs-incorporated":              {"TLI"},
-		"tristar":                                {trs},
-		"tristar-ampersand-red-sector-inc":       {"TRSi", trs, "Tristar"},
-		tlf:                                      {"TLF", "TRSi/Lightforce/Fusion"},
-		"twilight":                               {"TW"},
-		"twilight-zone":                          {"TZ"},
-		"twilight-designs-crew":                  {"TDC"},
-		"twin-sectors-inc":                       {"TSI"},
-		"tyranny":                                {"TYR", "TRN"},
-		"twenty-one-twelve-bbs":                  {"2112", "²''²"},
-		tsep:                                     {"TSEP"},
-		"turbo-nutter-kiwi-bastards":             {"TNKB", "KiWi"},
-		"ultra-tech":                             {"UT"},
-		"ultra-tech*electro-magnetic-crackers":   {"UT-EMC"},
-		"unified-legendary-traders-rising-again": {"ULTRA"},
-		"ultra-force":                            {"UF", "Ultraforce"},
-		"utilities-in-demand":                    {"UiD"},
-		"under-seh-team":                         {"UST"},
-		"underground-empire":                     {"UE"},
-		"union":                                  {"UNi"},
-		"united-albanian-reverse-engineers":      {"UARE"},
-		"united-artist-association":              {"UAA"},
-		"united-couriers":                        {"UC"},
-		"united-cracking-force":                  {"UCF"},
-		"universal-crackers-of-the-underground":  {"UCU"},
-		"united-group-international":             {"UGI"},
-		"united-reverse-engineering-team":        {"URET"},
-		"united-software-association*fairlight":  {"USA/Fairlight", "USA/FLT", "USA"},
-		"united-states-courier-report":           {"USCR"},
-		"united-traders-of-germany":              {"UTG"},
-		"united-file-traders":                    {"UFT"},
-		"underpl":                                {"UPL"},
-		"underground-kidz":                       {"UK"},
-		"underground-pirating-syndicate":         {"UPS"},
-		"unleashed":                              {"UNL"},
-		"underground-cracking-syndicate":         {"UCS"},
-		"unpacking-gods":                         {"UG"},
-		"untouchables":                           {unt},
-		"untouchable-art":                        {unt},
-		"velocity-couriers":                      {"VEL"},
-		"vendetta":                               {"VND"},
-		"vengeance":                              {"VGN", "VEN", "Vengeance"},
-		"vengeance-couriers":                     {"VGN"},
-		"vertex":                                 {"VTX"},
-		"very-strange-warez":                     {"VSW"},
-		"victoria-independent-piracy":            {"VIP"},
-		"vital-dox":                              {"VD"},
-		"vision-factory":                         {"VF"},
-		"visions-of-reality":                     {"VOR"},
-		"virility":                               {"VRL"},
-		"vitality":                               {"VIT", "VTL"},
-		"vortex":                                 {"VXT"},
-		"vortex-software":                        {"Vortex"},
-		"warez-anarchy-review":                   {"WAR"},
-		"worldwide-applications-release-system":  {"WARES"},
-		"world-domination-force":                 {"WDF"},
-		"wave":                                   {"The Wave", cnc},
-		"western-area-pirates":                   {"WARP"},
-		"delusions-of-grandeur":                  {"DoG"},
-		"warrior":                                {"WAR", "WARez RIng ORganization"},
-		"wdyl-wtn":                               {"WDYL"},
-		"west-coast-cracking-production":         {"WCCP"},
-		"well-release-anything":                  {"WRA"},
-		"wierd-new-world":                        {"WNW"}, //nolint:misspell
-		"wild-cards":                             {"WC", "WC!"},
-		"wicked":                                 {"WKD"},
-		"wizard-couriers":                        {"WC"},
-		"world-wide-couriers":                    {"WWC"},
-		"xap":                                    {"EX Apple Pirates"},
-		"x_large":                                {"XL"},
-		"x_factor":                               {"XFactor"},
-		"x_force":                                {"XF", "XForce", "X·Force"},
-		"xtreeme":                                {"XT"},
-		"youngsters-against-mcafee":              {"YAM"},
-		"ypogeios":                               {"YGS"},
-		"zero-waiting-time":                      {"ZWT"},
-		"zenith":                                 {"ZNTH"},
-		"zick-zack-cooperation":                  {"ZZC"},
-		"zone":                                   {"z0ne"},
-		"silent-chaos":                           {"SC"},
-		"complex-ftp":                            {"The Complex"},
-		"premiere":                               {"prm"},
-		"vdr-lake-ftp":                           {"VDR Lake", "Virtual Dimension Research", "vdrlake"},
-		"z-land-ftp":                             {"Zland"},
-		"utwente-ftp":                            {"UT"},
-		"sanctuary-ftp":                          {"The Sanctuary", "TS"},
-		"rising-sun-ftp":                         {"The Rising Sun"},
-		"oriental-pearl-ftp":                     {"The Oriental Pearl", "TOP"},
-		"desert-inn-ftp":                         {"The Desert Inn"},
-		"carpenters-haven-ftp":                   {"The Carpenter's Haven"},
-		"citadel-ftp":                            {"The Citadel"},
-		"the-copyright-power":                    {"TCP"},
-		"tekno-rage":                             {"TR"},
-		"pirasoft":                               {"PS"},
-		"novastorm":                              {"NS"},
-		"kovert-spreaders-inc":                   {"KSI"},
-		"virtual-dimension-research":             {"VDR"},
-		"digital-pirating-alliance":              {"DPA"},
-		"central-crime-association":              {"CCA"},
-		"dextrose":                               {"DX"},
-		"executive":                              {"EXCC", "EXE"},
-		"follow-my-religion":                     {"FMR"},
-		"grind-and-mcarec":                       {"GAM"},
-		"immortals":                              {"IMM", "IMS"},
-		"jazz-united-couriers":                   {"JUC"},
-		"karma":                                  {"KRMA", "KRM"},
-		"league":                                 {"LGE"},
-		"master-piece":                           {"MP"},
-		"men-in-black":                           {"MIB"},
-		"the-seekers-oasis":                      {"TSO"},
-		"we-love-warez":                          {"WLW"},
-		"warez-in-progress":                      {"WIP"},
-		"thunder":                                {"THD"},
-		"black-lotus-couriers":                   {"BLC"},
-		"chrome-matrix":                          {"CM"},
-		"co_operative":                           {"Co-Op"},
-		"children-of-the-grave":                  {"Cotg"},
-		"house-experience":                       {"HX"},
-		"phoenix-hitmen":                         {"PHX"},
-		"18plus2":                                {"18+2"},
-		"abrupt":                                 {"ABT"},
-		"arcane":                                 {"ARC"},
-		"damage-inkorporated-enterprises-2084":   {"DIE"},
-		"central-division":                       {"CD"},
-		"conehead-smos-games":                    {"SMOS", "SMS"},
-		"superficial":                            {"SfC"},
-		"real-warez-traders":                     {"RWT"},
-		"the-new-breed":                          {"TNB"},
-		"the-force-traderz":                      {"TFT"},
-		"the-gathering":                          {"TGA"},
-		"the-couriers-digest":                    {"TCD"},
-		"diebels-drinking-team":                  {"DDT"},
-		"pinnacle":                               {"PNC"},
-		"providence":                             {"PIE"},
-		"professionally-cracked-warez":           {"PCW"},
-		"vigor":                                  {"VG"},
-		"violence":                               {"VIO"},
-		"menace-to-society":                      {"MTS"},
-		"miracle":                                {"MRC"},
-		"legion":                                 {"LGN"},
-		"0day_dump":                              {"0DD"},
-		"doom":                                   {"Doom64", "DM"},
-		"lightning-couriers":                     {"LGT"},
-		"critical":                               {"CRT"},
-		"cats-are-cool":                          {"CRC"},
-		"forces-of-darkness":                     {"FOD"},
-		"death-and-destruction":                  {"DAD"},
-		"demolition":                             {"DMN"},
-		"densetsu":                               {dst},
-		"inner-sanctum-bbs":                      {"TIS", "The Inner Sanctum"},
-		"onyx":                                   {"ox"},
-		"federation-against-class":               {"FAC"},
-		"sneakers":                               {"SNK", "SKS"},
-		"sneakers-ftp":                           {"SnK"},
-		"highway-to-hell-ftp":                    {"H2H", "Highway II Hell", "highway 2 hell"},
-		"pleasure-n-joy":                         {"Pleasure'n'joy", "PNJ"},
-		"iarqua-57":                              {"IRQ", "IRQ57", "IRQ 57"},
-		"shit-hot-trading-posse":                 {"SHTP"},
-		"sonic-mind-warp":                        {"SMW"},
-		"mentality":                              {"mnt"},
-		"brand-beer-ii-ftp":                      {"bb2"},
-		"the-orgasmik-krew":                      {"TOK"},
-		"pirates-cove-ftp":                       {"PC"},
-		"toxic-dump-ftp":                         {"TTD"},
-		"darkstar":                               {"DS", "Dark*Star"},
-		"orbit":                                  {"OBT"},
-		"distortion":                             {dst, "DIS"},
-		"house-of-music-ftp":                     {"HOM"},
-		"phantom-quanqiutong-ftp":                {"QQT", "THP-QQT"},
-		"the-crackerz-company":                   {tcc},
-		"armageddon":                             {"AMG"},
-		"creator-of-darkness":                    {"COD"},
-		"dark-forces":                            {"DF"},
-		"outbreak-couriers":                      {"OB"},
-		"dead-legends-society":                   {"DLS"},
-		"parasite":                               {"PST"},
-		"emerald":                                {"ERD"},
-		"flatline":                               {"FL"},
-		"independent-releasing":                  {"iR"},
-		"mantis":                                 {"MNT"},
-		"australian-elite-force":                 {"AEF"},
-		"core-dump":                              {"CD"},
-		"world-wide-releasing":                   {"WWR"},
-		"far-beyond-insanity":                    {"Fbi"},
-		"the-reservoir-dogs":                     {"TRD"},
-		"new-order-bbs":                          {"The New Order", "tno"},
-		"romlight":                               {"RLT"},
-		"lifeless":                               {"LL"},
-		"original-gun-clappers":                  {"OGC"},
-		"bribe":                                  {"BBE"},
-		"black-circle-bbs":                       {"TBC"},
-		"lords-of-deception":                     {"l0D, lOD"},
-		"last-resort-ftp":                        {"TLR", "The Last Resort"},
-		"orgasm":                                 {"OGM"},
-		"the-age-of-creation":                    {"tac"},
-		"new-vision-couriers":                    {"NVC"},
-		"celebre":                                {"CLB"},
-		"ez-way-ftp":                             {"The Ez Way", "EZ", "eZWAY"},
-		"divide-by-zero-ftp":                     {"dbz"},
-		"coke-ftp":                               {"cOCA cOLA"},
-		"atlantis-ftp":                           {"atl"},
-		"air-force-one-ftp":                      {"aF1", "airforce one"},
-		"allied-mind-force":                      {"AMF"},
-		"bad-conscience-inc":                     {"BCi"},
-		"sphere-of-speed-bbs":                    {"sos"},
-		"sphere-of-speed-ftp":                    {"sos"},
-		"x_pression-design":                      {"X Pression", "xpression"},
-		"the-flame-arrows":                       {"TFA", "TFAiSO", "TFaMP", "TFAServices", "TFAAmiga"},
-		"ice-cold-productions":                   {"ICP", "I<P"},
-		"next-generation-pirates":                {"NGP"},
-		"digital-neurosis":                       {"DN"},
-		"rabid-neurosis":                         {"RNS"},
-		"rok":                                    {"Magic Island ROK", "Island ROK"},
-		"rise":                                   {"REALLY iNTO SPREADiNG ELiTE", "RISEiSO"},
-		"nuclear-dust-ftp":                       {"nud"},
-		"pre-whore-house-ftp":                    {"pwh"},
-		"pir8tes-pavilion-ftp":                   {"Pirates Pavilion", "pir"},
-		"fire-site-ftp":                          {"FireSite"},
-		"cemetery-gates-ftp":                     {"cm-g", "cmg"},
-		"rezurection":                            {"Rez"},
-		"magnetic-fields-ftp":                    {"MF", "MFiSO"},
-		"psychowarez":                            {"pwz"},
-		"judgement":                              {"JDt"},
-		"menace-ii-bbs":                          {"Menace 2", "Menace ]["},
-		"eiserne-front-bbs":                      {"EF"},
-		"x_factor-bbs":                           {"X Factor", "XFactor"},
-		"edge-of-honor-whq-bbs":                  {"Edge of Honor", "EOH"},
-		"akira-group-93":                         {"Akira 93", "AKA"},
-		"fighting-force":                         {"ffo"},
-		"stormzone-ftp":                          {"StormZone", "Storm Zone", "SZ"},
-		"the-digital-afterlife":                  {"TDA"},
-		"shock-demo":                             {"Shock!"},
-		"nectar-base-bbs":                        {"The Nectar Base BBS"},
-		"sonic":                                  {"Sonic64", "son"},
-		"mnemonic-crackers":                      {"mnc"},
-		"shock":                                  {"SHOCKiSO", "SHOCK iSO", "SHOCKpDA"},
-		"crime-syndicate-bbs":                    {"TCS BBS", "The Crime Syndicate BBS"},
-		"palace-of-exile-bbs":                    {"The Palace of Exile BBS"},
-		"cartel-bbs":                             {"The Cartel BBS"},
-		"crime-cartel-bbs":                       {"TCC BBS", "The Crime Cartel BBS"},
-		"fx-bbs":                                 {"F/X BBS"},
-		"latitude-zero-bbs":                      {"LZ BBS"},
-		"countdown-to-extinction-bbs":            {"CTE BBS"},
-		"vision_x-bbs":                           {"Vision X BBS Software"},
-		"hardwired-bbs":                          {"Hard Wired BBS"},
-		"jungle-bbs":                             {"The Jungle BBS"},
-		"lush-software-designs-bbs":              {"LSD BBS"},
-		"revolutions-per-minute-bbs":             {"RPM BBS"},
-		"dark-crusade-bbs":                       {"The Dark Crusade BBS", "TDC BBS"},
-		"beyond-the-realm-of-reality-bbs":        {"Beyond BBS", "BRR BBS"},
-		"psycho_neurosis-bbs":                    {"Psychoneurosis BBS", "Psycho Neurosis BBS"},
+		"united-states-courier-report":          {initial("USCR")},
+		"united-traders-of-germany":             {initial("UTG")},
+		"united-file-traders":                   {initial("UFT")},
+		"underpl":                               {initial("UPL")},
+		"underground-kidz":                      {initial("UK")},
+		"underground-pirating-syndicate":        {initial("UPS")},
+		"unleashed":                             {alias("UNL")},
+		"underground-cracking-syndicate":        {initial("UCS")},
+		"unpacking-gods":                        {initial("UG")},
+		"untouchables":                          {alias(unt)},
+		"untouchable-art":                       {alias(unt)},
+		"velocity-couriers":                     {alias("VEL")},
+		"vendetta":                              {initial("VND")},
+		"vengeance":                             {initial("VGN"), alias("VEN"), alias("Vengeance")},
+		"vengeance-couriers":                    {initial("VGN")},
+		"vertex":                                {initial("VTX")},
+		"very-strange-warez":                    {initial("VSW")},
+		"victoria-independent-piracy":           {initial("VIP")},
+		"vital-dox":                             {initial("VD")},
+		"vision-factory":                        {initial("VF")},
+		"visions-of-reality":                    {initial("VOR")},
+		"virility":                              {initial("VRL")},
+		"vitality":                              {alias("VIT"), initial("VTL")},
+		"vortex":                                {initial("VXT")},
+		"vortex-software":                       {alias("Vortex")},
+		"warez-anarchy-review":                  {alias("WAR")},
+		"worldwide-applications-release-system": {acronym("WARES")},
+		"world-domination-force":                {initial("WDF")},
+		"wave":                                  {alias("The Wave"), initial(cnc)},
+		"western-area-pirates":                  {acronym("WARP")},
+		"delusions-of-grandeur":                 {initial("DoG")},
+		"warrior":                               {alias("WAR"), alias("WARez RIng ORganization")},
+		"wdyl-wtn":                              {alias("WDYL")},
+		"west-coast-cracking-production":        {initial("WCCP")},
+		"well-release-anything":                 {initial("WRA")},
+		"wierd-new-world":                       {initial("WNW")}, //nolint:misspell
+		"wild-cards":                            {initial("WC"), initial("WC!")},
+		"wicked":                                {initial("WKD")},
+		"wizard-couriers":                       {initial("WC")},
+		"world-wide-couriers":                   {initial("WWC")},
+		"xap":                                   {alias("EX Apple Pirates")},
+		"x_large":                               {initial("XL")},
+		"x_factor":                              {alias("XFactor")},
+		"x_force":                               {initial("XF"), alias("XForce"), alias("X·Force")},
+		"xtreeme":                               {initial("XT")},
+		"youngsters-against-mcafee":             {initial("YAM")},
+		"ypogeios":                              {initial("YGS")},
+		"zero-waiting-time":                     {initial("ZWT")},
+		"zenith":                                {initial("ZNTH")},
+		"zick-zack-cooperation":                 {initial("ZZC")},
+		"zone":                                  {alias("z0ne")},
+		"silent-chaos":                          {initial("SC")},
+		"complex-ftp":                           {alias("The Complex")},
+		"premiere":                              {initial("prm")},
+		"vdr-lake-ftp":                          {alias("VDR Lake"), alias("Virtual Dimension Research"), alias("vdrlake")},
+		"z-land-ftp":                            {alias("Zland")},
+		"utwente-ftp":                           {initial("UT")},
+		"sanctuary-ftp":                         {alias("The Sanctuary"), initial("TS")},
+		"rising-sun-ftp":                        {alias("The Rising Sun")},
+		"oriental-pearl-ftp":                    {alias("The Oriental Pearl"), initial("TOP")},
+		"desert-inn-ftp":                        {alias("The Desert Inn")},
+		"carpenters-haven-ftp":                  {alias("The Carpenter's Haven")},
+		"citadel-ftp":                           {alias("The Citadel")},
+		"the-copyright-power":                   {initial("TCP")},
+		"tekno-rage":                            {initial("TR")},
+		"pirasoft":                              {initial("PS")},
+		"novastorm":                             {initial("NS")},
+		"kovert-spreaders-inc":                  {initial("KSI")},
+		"virtual-dimension-research":            {initial("VDR")},
+		"digital-pirating-alliance":             {initial("DPA")},
+		"central-crime-association":             {initial("CCA")},
+		"dextrose":                              {initial("DX")},
+		"executive":                             {initial("EXCC"), alias("EXE")},
+		"follow-my-religion":                    {initial("FMR")},
+		"grind-and-mcarec":                      {initial("GAM")},
+		"immortals":                             {alias("IMM"), initial("IMS")},
+		"jazz-united-couriers":                  {initial("JUC")},
+		"karma":                                 {initial("KRMA"), initial("KRM")},
+		"league":                                {initial("LGE")},
+		"master-piece":                          {initial("MP")},
+		"men-in-black":                          {initial("MIB")},
+		"the-seekers-oasis":                     {initial("TSO")},
+		"we-love-warez":                         {initial("WLW")},
+		"warez-in-progress":                     {initial("WIP")},
+		"thunder":                               {initial("THD")},
+		"black-lotus-couriers":                  {initial("BLC")},
+		"chrome-matrix":                         {initial("CM")},
+		"co_operative":                          {alias("Co-Op")},
+		"children-of-the-grave":                 {initial("Cotg")},
+		"house-experience":                      {initial("HX")},
+		"phoenix-hitmen":                        {initial("PHX")},
+		"18plus2":                               {initial("18+2")},
+		"abrupt":                                {initial("ABT")},
+		"arcane":                                {alias("ARC")},
+		"damage-inkorporated-enterprises-2084":  {initial("DIE")},
+		"central-division":                      {initial("CD")},
+		"conehead-smos-games":                   {alias("SMOS"), initial("SMS")},
+		"superficial":                           {initial("SfC")},
+		"real-warez-traders":                    {initial("RWT")},
+		"the-new-breed":                         {initial("TNB")},
+		"the-force-traderz":                     {initial("TFT")},
+		"the-gathering":                         {initial("TGA")},
+		"the-couriers-digest":                   {initial("TCD")},
+		"diebels-drinking-team":                 {initial("DDT")},
+		"pinnacle":                              {initial("PNC")},
+		"providence":                            {initial("PIE")},
+		"professionally-cracked-warez":          {initial("PCW")},
+		"vigor":                                 {initial("VG")},
+		"violence":                              {alias("VIO")},
+		"menace-to-society":                     {initial("MTS")},
+		"miracle":                               {initial("MRC")},
+		"legion":                                {initial("LGN")},
+		"0day_dump":                             {initial("0DD")},
+		"doom":                                  {alias("Doom64"), initial("DM")},
+		"lightning-couriers":                    {initial("LGT")},
+		"critical":                              {initial("CRT")},
+		"cats-are-cool":                         {initial("CRC")},
+		"forces-of-darkness":                    {initial("FOD")},
+		"death-and-destruction":                 {initial("DAD")},
+		"demolition":                            {initial("DMN")},
+		"densetsu":                              {initial(dst)},
+		"inner-sanctum-bbs":                     {initial("TIS"), alias("The Inner Sanctum")},
+		"onyx":                                  {initial("ox")},
+		"federation-against-class":              {initial("FAC")},
+		"sneakers":                              {initial("SNK"), initial("SKS")},
+		"sneakers-ftp":                          {initial("SnK")},
+		"highway-to-hell-ftp":                   {initial("H2H"), alias("Highway II Hell"), alias("highway 2 hell")},
+		"pleasure-n-joy":                        {alias("Pleasure'n'joy"), initial("PNJ")},
+		"iarqua-57":                             {initial("IRQ"), initial("IRQ57"), alias("IRQ 57")},
+		"shit-hot-trading-posse":                {initial("SHTP")},
+		"sonic-mind-warp":                       {initial("SMW")},
+		"mentality":                             {initial("mnt")},
+		"brand-beer-ii-ftp":                     {alias("bb2")},
+		"the-orgasmik-krew":                     {initial("TOK")},
+		"pirates-cove-ftp":                      {initial("PC")},
+		"toxic-dump-ftp":                        {initial("TTD")},
+		"darkstar":                              {initial("DS"), alias("Dark*Star")},
+		"orbit":                                 {initial("OBT")},
+		"distortion":                            {initial(dst), alias("DIS")},
+		"house-of-music-ftp":                    {initial("HOM")},
+		"phantom-quanqiutong-ftp":               {initial("QQT"), alias("THP-QQT")},
+		"the-crackerz-company":                  {initial(tcc)},
+		"armageddon":                            {initial("AMG")},
+		"creator-of-darkness":                   {initial("COD")},
+		"dark-forces":                           {initial("DF")},
+		"outbreak-couriers":                     {initial("OB")},
+		"dead-legends-society":                  {initial("DLS")},
+		"parasite":                              {initial("PST")},
+		"emerald":                               {initial("ERD")},
+		"flatline":                              {initial("FL")},
+		"independent-releasing":                 {initial("iR")},
+		"mantis":                                {initial("MNT")},
+		"australian-elite-force":                {initial("AEF")},
+		"core-dump":                             {initial("CD")},
+		"world-wide-releasing":                  {initial("WWR")},
+		"far-beyond-insanity":                   {initial("Fbi")},
+		"the-reservoir-dogs":                    {initial("TRD")},
+		"new-order-bbs":                         {alias("The New Order"), alias("tno")},
+		"romlight":                              {initial("RLT")},
+		"lifeless":                              {initial("LL")},
+		"original-gun-clappers":                 {initial("OGC")},
+		"bribe":                                 {initial("BBE")},
+		"black-circle-bbs":                      {initial("TBC")},
+		"lords-of-deception":                    {alias("l0D, lOD")},
+		"last-resort-ftp":                       {initial("TLR"), alias("The Last Resort")},
+		"orgasm":                                {initial("OGM")},
+		"the-age-of-creation":                   {initial("tac")},
+		"new-vision-couriers":                   {initial("NVC")},
+		"celebre":                               {initial("CLB")},
+		"ez-way-ftp":                            {alias("The Ez Way"), alias("EZ"), alias("eZWAY")},
+		"divide-by-zero-ftp":                    {initial("dbz")},
+		"coke-ftp":                              {alias("cOCA cOLA")},
+		"atlantis-ftp":                          {alias("atl")},
+		"air-force-one-ftp":                     {alias("aF1"), alias("airforce one")},
+		"allied-mind-force":                     {initial("AMF")},
+		"bad-conscience-inc":                    {initial("BCi")},
+		"sphere-of-speed-bbs":                   {initial("sos")},
+		"sphere-of-speed-ftp":                   {initial("sos")},
+		"x_pression-design":                     {alias("X Pression"), alias("xpression")},
+		"the-flame-arrows": {
+			initial("TFA"), initial("TFAiSO"), alias("TFaMP"), alias("TFAServices"),
+			alias("TFAAmiga"),
+		},
+		"ice-cold-productions":            {initial("ICP"), initial("I<P")},
+		"next-generation-pirates":         {initial("NGP")},
+		"digital-neurosis":                {initial("DN")},
+		"rabid-neurosis":                  {initial("RNS")},
+		"rok":                             {alias("Magic Island ROK"), alias("Island ROK")},
+		"rise":                            {alias("REALLY iNTO SPREADiNG ELiTE"), initial("RISEiSO")},
+		"nuclear-dust-ftp":                {initial("nud")},
+		"pre-whore-house-ftp":             {initial("pwh")},
+		"pir8tes-pavilion-ftp":            {alias("Pirates Pavilion"), alias("pir")},
+		"fire-site-ftp":                   {alias("FireSite")},
+		"cemetery-gates-ftp":              {initial("cm-g"), initial("cmg")},
+		"rezurection":                     {alias("Rez")},
+		"magnetic-fields-ftp":             {initial("MF"), initial("MFiSO")},
+		"psychowarez":                     {initial("pwz")},
+		"judgement":                       {initial("JDt")},
+		"menace-ii-bbs":                   {alias("Menace 2"), alias("Menace ][")},
+		"eiserne-front-bbs":               {initial("EF")},
+		"x_factor-bbs":                    {alias("X Factor"), alias("XFactor")},
+		"edge-of-honor-whq-bbs":           {alias("Edge of Honor"), initial("EOH")},
+		"akira-group-93":                  {alias("Akira 93"), initial("AKA")},
+		"fighting-force":                  {initial("ffo")},
+		"stormzone-ftp":                   {alias("StormZone"), alias("Storm Zone"), initial("SZ")},
+		"the-digital-afterlife":           {initial("TDA")},
+		"shock-demo":                      {alias("Shock!")},
+		"nectar-base-bbs":                 {alias("The Nectar Base BBS")},
+		"sonic":                           {alias("Sonic64"), alias("son")},
+		"mnemonic-crackers":               {initial("mnc")},
+		"shock":                           {alias("SHOCKiSO"), alias("SHOCK iSO"), alias("SHOCKpDA")},
+		"crime-syndicate-bbs":             {initial("TCS BBS"), alias("The Crime Syndicate BBS")},
+		"palace-of-exile-bbs":             {alias("The Palace of Exile BBS")},
+		"cartel-bbs":                      {alias("The Cartel BBS")},
+		"crime-cartel-bbs":                {initial("TCC BBS"), alias("The Crime Cartel BBS")},
+		"fx-bbs":                          {alias("F/X BBS")},
+		"latitude-zero-bbs":               {initial("LZ BBS")},
+		"countdown-to-extinction-bbs":     {initial("CTE BBS")},
+		"vision_x-bbs":                    {alias("Vision X BBS Software")},
+		"hardwired-bbs":                   {alias("Hard Wired BBS")},
+		"jungle-bbs":                      {alias("The Jungle BBS")},
+		"lush-software-designs-bbs":       {initial("LSD BBS")},
+		"revolutions-per-minute-bbs":      {initial("RPM BBS")},
+		"dark-crusade-bbs":                {alias("The Dark Crusade BBS"), initial("TDC BBS")},
+		"beyond-the-realm-of-reality-bbs": {alias("Beyond BBS"), initial("BRR BBS")},
+		"psycho_neurosis-bbs":             {alias("Psychoneurosis BBS"), alias("Psycho Neurosis BBS")},
 	}
-	return &list
+	return list
 }
 
 // Initialism returns the alternative spellings, acronyms and initialisms for the URL path.
@@ -1473,8 +1534,7 @@ func Initialism(path Path) []string {
 //	IsInitialism("defacto2") = true
 //	IsInitialism("some-random-bbs") = false
 func IsInitialism(path Path) bool {
-	initialism := *Initialisms()
-	_, match := initialism[path]
+	_, match := dictionary()[path]
 	return match
 }
 
@@ -1494,15 +1554,25 @@ func Join(path Path) string {
 	return strings.Join(i, ", ")
 }
 
+// JoinAbbr returns the alternative spellings, acronyms and initialisms for the
+// URL path as a comma separated HTML string, with the acronyms and initialisms
+// wrapped in an <abbr> element that uses the title, see [Spelling.Abbr].
+// Or an empty string if the URL path has no initialism.
+//
+// Example:
+//
+//	JoinAbbr("the-firm", "The Firm") = `FiRM, <abbr title="The Firm" aria-label="F R M">FRM</abbr>`
+func JoinAbbr(path Path, title string) string {
+	spellings := Spellings(path)
+	values := make([]string, 0, len(spellings))
+	for _, s := range spellings {
+		values = append(values, s.Abbr(title))
+	}
+	return strings.Join(values, ", ")
+}
+
 // Match returns the list of initialisms that match the given string.
+// The match is case-insensitive and the URL paths are sorted.
 func Match(s string) []Path {
-	var partials []Path
-	for partial, values := range maps.All(*Initialisms()) {
-		for value := range slices.Values(values) {
-			if strings.EqualFold(value, s) {
-				partials = append(partials, partial)
-			}
-		}
-	}
-	return partials
+	return slices.Clone(reversed()[strings.ToLower(s)])
 }
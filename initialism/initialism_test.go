@@ -41,6 +41,11 @@ func ExampleJoin() {
 	// USA/Fairlight, USA/FLT, USA
 }
 
+func ExampleJoinAbbr() {
+	fmt.Println(initialism.JoinAbbr("the-firm", "The Firm"))
+	// Output: FiRM, <abbr title="The Firm" aria-label="F R M">FRM</abbr>
+}
+
 func TestMatch(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
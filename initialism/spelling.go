@@ -0,0 +1,179 @@
+package initialism
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+// A Type is the kind of alternative spelling.
+type Type uint8
+
+// The types of alternative spelling.
+const (
+	TypeAlias      Type = iota // TypeAlias is the same name with a different casing, spelling or punctuation, e.g. Razor.
+	TypeAcronym                // TypeAcronym is an abbreviation that is pronounced as a word, e.g. NAPPA.
+	TypeInitialism             // TypeInitialism is an abbreviation that is spelled out letter by letter, e.g. TDT.
+)
+
+// String returns the name of the type.
+func (t Type) String() string {
+	switch t {
+	case TypeAlias:
+		return "alias"
+	case TypeAcronym:
+		return "acronym"
+	case TypeInitialism:
+		return "initialism"
+	}
+	return ""
+}
+
+// Weight returns the search weight of the type, so a true initialism
+// or acronym ranks above a loose alias.
+func (t Type) Weight() int {
+	const alias, acronym, initialism = 1, 2, 3
+	switch t {
+	case TypeInitialism:
+		return initialism
+	case TypeAcronym:
+		return acronym
+	}
+	return alias
+}
+
+// A Spelling is an alternative spelling, acronym or initialism of a releaser.
+type Spelling struct {
+	Value string // Value is the spelling as it is listed, e.g. "TDT".
+	Type  Type   // Type is the kind of spelling.
+	Notes string // Notes are the optional curator notes about the spelling.
+}
+
+// Spoken returns the spelling as it is read aloud, which is useful for screen readers.
+// Initialisms are spelled out letter by letter, while acronyms and aliases are returned as is.
+//
+// Example:
+//
+//	Spelling{Value: "TDT", Type: TypeInitialism}.Spoken() = "T D T"
+//	Spelling{Value: "NAPPA", Type: TypeAcronym}.Spoken() = "NAPPA"
+func (s Spelling) Spoken() string {
+	if s.Type != TypeInitialism {
+		return s.Value
+	}
+	letters := []string{}
+	for _, r := range s.Value {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			letters = append(letters, string(unicode.ToUpper(r)))
+		}
+	}
+	return strings.Join(letters, " ")
+}
+
+// Abbr returns the spelling as an HTML <abbr> element that uses the full name as its title.
+// Initialisms also get an aria-label with the [Spelling.Spoken] form, so screen readers
+// spell them out letter by letter, while acronyms are read as a word.
+// Aliases are not abbreviations and are returned as escaped text.
+//
+// Example:
+//
+//	Spelling{Value: "TDT", Type: TypeInitialism}.Abbr("The Dream Team") =
+//		`<abbr title="The Dream Team" aria-label="T D T">TDT</abbr>`
+//	Spelling{Value: "NAPPA", Type: TypeAcronym}.Abbr("North American Pirate-Phreak Association") =
+//		`<abbr title="North American Pirate-Phreak Association">NAPPA</abbr>`
+//	Spelling{Value: "Razor", Type: TypeAlias}.Abbr("Razor 1911") = `Razor`
+func (s Spelling) Abbr(title string) string {
+	if s.Type == TypeAlias || title == "" {
+		return html.EscapeString(s.Value)
+	}
+	label := ""
+	if s.Type == TypeInitialism {
+		label = ` aria-label="` + html.EscapeString(s.Spoken()) + `"`
+	}
+	return `<abbr title="` + html.EscapeString(title) + `"` + label + `>` + html.EscapeString(s.Value) + `</abbr>`
+}
+
+// Classify guesses the type of a spelling from its letters, which is useful when
+// a new spelling is added to the list. The listed spellings record their own type,
+// see [Spellings].
+//
+//   - Spellings with spaces, slashes or lowercase letters, other than the scene-style i, are aliases
+//   - Spellings of three letters or less are initialisms
+//   - Longer spellings that can be pronounced as a word are acronyms, such as NAPPA or SCUD
+//   - Otherwise the spelling is an initialism
+//
+// Example:
+//
+//	Classify("Razor") = TypeAlias
+//	Classify("TDT") = TypeInitialism
+//	Classify("TRSi") = TypeInitialism
+//	Classify("NAPPA") = TypeAcronym
+func Classify(value string) Type {
+	if value == "" || strings.ContainsAny(value, " /") {
+		return TypeAlias
+	}
+	letters := []rune{}
+	for i, r := range value {
+		switch {
+		case unicode.IsUpper(r):
+			letters = append(letters, r)
+		case r == 'i' && len(value) > 1:
+			letters = append(letters, 'I')
+		case unicode.IsLower(r):
+			return TypeAlias
+		case unicode.IsDigit(r), r == '.' && i > 0:
+		default:
+			return TypeAlias
+		}
+	}
+	const short = 3
+	if len(letters) <= short || strings.Contains(value, ".") || !pronounceable(letters) {
+		return TypeInitialism
+	}
+	return TypeAcronym
+}
+
+// pronounceable returns true if the uppercase letters contain a vowel, begin with
+// a common English consonant pair, have no runs of more than two consonants and no repeated vowels.
+func pronounceable(letters []rune) bool {
+	vowel := func(r rune) bool {
+		return strings.ContainsRune("AEIOUY", r)
+	}
+	const pair = 2
+	if len(letters) > pair && !vowel(letters[0]) && !vowel(letters[1]) {
+		switch string(letters[:pair]) {
+		case "BL", "BR", "CH", "CL", "CR", "DR", "FL", "FR", "GL", "GR", "KN", "PH", "PL", "PR",
+			"SC", "SH", "SK", "SL", "SM", "SN", "SP", "ST", "SW", "TH", "TR", "TW", "WH", "WR":
+		default:
+			return false
+		}
+	}
+	hasVowel := false
+	consonants := 0
+	for i, r := range letters {
+		if !vowel(r) {
+			consonants++
+			const maxConsonants = 2
+			if consonants > maxConsonants {
+				return false
+			}
+			continue
+		}
+		hasVowel = true
+		consonants = 0
+		if i > 0 && vowel(letters[i-1]) {
+			return false
+		}
+	}
+	return hasVowel
+}
+
+// Spellings returns the alternative spellings, acronyms and initialisms for
+// the URL path with their listed type and any curator notes.
+// Or an empty slice if the URL path has no initialism.
+//
+// Example:
+//
+//	Spellings("the-dream-team") = []Spelling{{Value: "TDT", Type: TypeInitialism}}
+func Spellings(path Path) []Spelling {
+	return append([]Spelling{}, dictionary()[path]...)
+}
@@ -0,0 +1,106 @@
+package initialism_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Defacto2/releaser/initialism"
+	"github.com/nalgeon/be"
+)
+
+func ExampleSpellings() {
+	for _, s := range initialism.Spellings("the-firm") {
+		fmt.Println(s.Value, s.Type)
+	}
+	// Output: FiRM alias
+	// FRM initialism
+}
+
+func ExampleSpelling_Spoken() {
+	s := initialism.Spelling{Value: "TDT", Type: initialism.TypeInitialism}
+	fmt.Println(s.Spoken())
+	// Output: T D T
+}
+
+func TestClassify(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		value string
+		want  initialism.Type
+	}{
+		{"", initialism.TypeAlias},
+		{"Razor", initialism.TypeAlias},
+		{"Razor CD", initialism.TypeAlias},
+		{"USA/FLT", initialism.TypeAlias},
+		{"TDT", initialism.TypeInitialism},
+		{"TRSi", initialism.TypeInitialism},
+		{"DF2", initialism.TypeInitialism},
+		{"A.C.E.", initialism.TypeInitialism},
+		{"TSEP", initialism.TypeInitialism},
+		{"MGSCI", initialism.TypeInitialism},
+		{"NAPPA", initialism.TypeAcronym},
+		{"SCUD", initialism.TypeAcronym},
+		{"EPIX", initialism.TypeAcronym},
+		{"IIRG", initialism.TypeInitialism},
+		{"USA", initialism.TypeInitialism},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			t.Parallel()
+			be.Equal(t, initialism.Classify(tt.value), tt.want)
+		})
+	}
+}
+
+func TestInitialismsTyped(t *testing.T) {
+	t.Parallel()
+	// every listed spelling must record a known type
+	for path := range *initialism.Initialisms() {
+		for _, s := range initialism.Spellings(path) {
+			be.True(t, s.Value != "")
+			if s.Type.String() == "" {
+				t.Errorf("%s: %q has an unknown type %d", path, s.Value, s.Type)
+			}
+		}
+	}
+}
+
+func TestSpellings(t *testing.T) {
+	t.Parallel()
+	be.Equal(t, initialism.Spellings("the-dream-team"), []initialism.Spelling{
+		{Value: "TDT", Type: initialism.TypeInitialism},
+	})
+	be.Equal(t, len(initialism.Spellings("some-random-bbs")), 0)
+	// the listed type is used even when the letters suggest otherwise
+	be.Equal(t, initialism.Classify("ACE"), initialism.TypeInitialism)
+	be.Equal(t, initialism.Spellings("art-creation-enterprise")[0], initialism.Spelling{
+		Value: "ACE", Type: initialism.TypeAcronym,
+	})
+	be.Equal(t, initialism.Spellings("drink-or-die")[0].Notes, "also the initialism of Delirium of Disorder")
+	for _, s := range initialism.Spellings("united-software-association*fairlight") {
+		if s.Value == "USA" {
+			be.Equal(t, s.Type, initialism.TypeInitialism)
+			be.True(t, s.Notes != "")
+		}
+	}
+}
+
+func TestType(t *testing.T) {
+	t.Parallel()
+	be.Equal(t, initialism.TypeAcronym.String(), "acronym")
+	be.True(t, initialism.TypeInitialism.Weight() > initialism.TypeAlias.Weight())
+	be.True(t, initialism.TypeAcronym.Weight() > initialism.TypeAlias.Weight())
+}
+
+func TestSpellingAbbr(t *testing.T) {
+	t.Parallel()
+	s := initialism.Spelling{Value: "TDT", Type: initialism.TypeInitialism}
+	be.Equal(t, s.Abbr("The Dream Team"), `<abbr title="The Dream Team" aria-label="T D T">TDT</abbr>`)
+	be.Equal(t, s.Abbr(""), "TDT")
+	s = initialism.Spelling{Value: "R&D", Type: initialism.TypeAlias}
+	be.Equal(t, s.Abbr("Razor"), "R&amp;D")
+	s = initialism.Spelling{Value: "NAPPA", Type: initialism.TypeAcronym}
+	be.Equal(t, s.Spoken(), "NAPPA")
+	be.Equal(t, s.Abbr("North American Pirate-Phreak Association"),
+		`<abbr title="North American Pirate-Phreak Association">NAPPA</abbr>`)
+}
@@ -0,0 +1,193 @@
+package initialism
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/Defacto2/releaser/fix"
+	"github.com/Defacto2/releaser/name"
+)
+
+// A Method is the way a suggested initialism is derived from the words of a name.
+type Method uint8
+
+// The methods used to derive the suggested initialisms.
+const (
+	FirstLetters Method = iota // FirstLetters uses the first letter of every word, e.g. TDT for The Dream Team.
+	NoConnect                  // NoConnect skips the connecting words such as "of" or "and", e.g. EPIE.
+	SceneCase                  // SceneCase lowercases the letter I that follows the first letter, e.g. TRSi.
+)
+
+// String returns the name of the method.
+func (m Method) String() string {
+	switch m {
+	case FirstLetters:
+		return "first letters"
+	case NoConnect:
+		return "no connecting words"
+	case SceneCase:
+		return "scene casing"
+	}
+	return ""
+}
+
+// A Suggestion is a candidate initialism for a releaser.
+type Suggestion struct {
+	Value      string // Value is the suggested initialism, e.g. "TDT".
+	Method     Method // Method is the way the initialism was derived.
+	Score      int    // Score is the suitability of the initialism, from 0 to 100.
+	Listed     bool   // Listed is true if the initialism is already listed for the releaser with the same casing.
+	Collisions []Path // Collisions are the other releasers that use the same initialism in any casing.
+}
+
+// Collides returns true if the initialism is already used by another releaser.
+func (s Suggestion) Collides() bool {
+	return len(s.Collisions) > 0
+}
+
+// scores used to rank the suggestions.
+const (
+	maxScore       = 100
+	lengthPenalty  = 15 // lengthPenalty is applied to each letter outside of the ideal length.
+	connectPenalty = 10 // connectPenalty is applied to first letters that include connecting words.
+	scenePenalty   = 5  // scenePenalty is applied to the scene casing of an initialism.
+	collideScore   = 40 // collideScore is applied to initialisms used by other releasers.
+	minLength      = 2
+	idealMin       = 3
+	idealMax       = 5
+)
+
+// Suggest returns the candidate initialisms for the URL path, derived from the words
+// of the humanized name. The suggestions are sorted by the highest score first.
+// Initialisms that are shorter than two letters are not suggested,
+// so the names of a single word return no suggestions.
+//
+// Suggestions score highest when they are three to five letters long and are not used by
+// another releaser. An initialism that is used by another releaser is flagged by its Collisions.
+//
+// Example:
+//
+//	Suggest("the-dream-team")[0] = Suggestion{Value: "TDT", Method: FirstLetters, Score: 100, Listed: true}
+//	Suggest("tristar-ampersand-red-sector-inc")[1] = Suggestion{Value: "TRSi", Method: SceneCase, Score: 95, Listed: true}
+//	Suggest("empire-of-pirate-intelligence-and-experts")[0] = Suggestion{Value: "EPIE", Method: NoConnect, Score: 100}
+func Suggest(path Path) []Suggestion {
+	s, err := name.Humanize(name.Path(path))
+	if err != nil {
+		return nil
+	}
+	words := strings.FieldsFunc(s, func(r rune) bool {
+		return unicode.IsSpace(r) || r == '-' || r == ','
+	})
+	words = slices.DeleteFunc(words, func(w string) bool {
+		return strings.IndexFunc(w, func(r rune) bool {
+			return unicode.IsLetter(r) || unicode.IsDigit(r)
+		}) < 0
+	})
+	all := letters(words, false)
+	skipped := letters(words, true)
+	found := []Suggestion{}
+	add := func(value string, method Method, penalty int) {
+		if utf8.RuneCountInString(value) < minLength {
+			return
+		}
+		if slices.ContainsFunc(found, func(s Suggestion) bool { return s.Value == value }) {
+			return
+		}
+		found = append(found, Suggestion{Value: value, Method: method, Score: maxScore - penalty})
+	}
+	if skipped != all {
+		add(skipped, NoConnect, 0)
+		add(all, FirstLetters, connectPenalty)
+		add(scene(skipped), SceneCase, scenePenalty)
+	} else {
+		add(all, FirstLetters, 0)
+	}
+	add(scene(all), SceneCase, scenePenalty)
+	list, index := listed(), reversed()
+	for i, s := range found {
+		found[i].Listed = slices.Contains(list[path], s.Value)
+		for _, p := range index[strings.ToLower(s.Value)] {
+			if p != path {
+				found[i].Collisions = append(found[i].Collisions, p)
+			}
+		}
+		score := s.Score - length(s.Value)
+		if found[i].Collides() {
+			score -= collideScore
+		}
+		found[i].Score = max(score, 0)
+	}
+	slices.SortStableFunc(found, func(a, b Suggestion) int {
+		return cmp.Compare(b.Score, a.Score)
+	})
+	return found
+}
+
+// listed is the lazily built list of initialisms that is used by Suggest.
+var listed = sync.OnceValue(func() List { //nolint:gochecknoglobals
+	return *Initialisms()
+})
+
+// reversed is the lazily built reverse index of the listed initialisms that is used by Suggest and Match.
+var reversed = sync.OnceValue(func() map[string][]Path { //nolint:gochecknoglobals
+	return reverse(listed())
+})
+
+// letters returns the uppercased first letters of the words.
+// Numbers are kept whole, and when skip is true the connecting words are skipped.
+func letters(words []string, skip bool) string {
+	var b strings.Builder
+	last := len(words) - 1
+	for i, w := range words {
+		if skip && fix.Connect(w, i, last) != "" {
+			continue
+		}
+		r, _ := utf8.DecodeRuneInString(w)
+		if unicode.IsDigit(r) {
+			b.WriteString(w)
+			continue
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return b.String()
+}
+
+// scene returns the initialism with the letter I lowercased in scene-style,
+// unless it is the first letter.
+func scene(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	return string(r) + strings.ReplaceAll(s[size:], "I", "i")
+}
+
+// length returns the penalty for an initialism outside of the ideal length.
+func length(s string) int {
+	n := utf8.RuneCountInString(s)
+	switch {
+	case n < idealMin:
+		return (idealMin - n) * lengthPenalty
+	case n > idealMax:
+		return (n - idealMax) * lengthPenalty
+	}
+	return 0
+}
+
+// reverse returns the URL paths of the releasers keyed by their lowercased initialisms.
+func reverse(list List) map[string][]Path {
+	index := make(map[string][]Path)
+	for path, values := range list {
+		for _, value := range values {
+			key := strings.ToLower(value)
+			if !slices.Contains(index[key], path) {
+				index[key] = append(index[key], path)
+			}
+		}
+	}
+	for key := range index {
+		slices.Sort(index[key])
+	}
+	return index
+}
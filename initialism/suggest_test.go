@@ -0,0 +1,74 @@
+package initialism_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Defacto2/releaser/initialism"
+	"github.com/nalgeon/be"
+)
+
+func ExampleSuggest() {
+	for _, s := range initialism.Suggest("tristar-ampersand-red-sector-inc") {
+		fmt.Println(s.Value, s.Method, s.Score, s.Listed)
+	}
+	// Output: TRSI first letters 100 false
+	// TRSi scene casing 95 true
+}
+
+func TestSuggest(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		path   initialism.Path
+		value  string
+		method initialism.Method
+		listed bool
+	}{
+		{"the-dream-team", "TDT", initialism.FirstLetters, true},
+		{"north-american-pirate_phreak-association", "NAPPA", initialism.FirstLetters, true},
+		{"international-information-retrieval-guild", "IIRG", initialism.FirstLetters, true},
+		{"empire-of-pirate-intelligence-and-experts", "EPIE", initialism.NoConnect, false},
+		{"razor-1911", "R1911", initialism.FirstLetters, false},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.path), func(t *testing.T) {
+			t.Parallel()
+			got := initialism.Suggest(tt.path)
+			be.True(t, len(got) > 0)
+			be.Equal(t, got[0].Value, tt.value)
+			be.Equal(t, got[0].Method, tt.method)
+			be.Equal(t, got[0].Listed, tt.listed)
+		})
+	}
+}
+
+func TestSuggestNone(t *testing.T) {
+	t.Parallel()
+	be.Equal(t, len(initialism.Suggest("")), 0)
+	be.Equal(t, len(initialism.Suggest("fairlight")), 0)
+	be.Equal(t, len(initialism.Suggest("razor#1911")), 0)
+}
+
+func TestSuggestCollides(t *testing.T) {
+	t.Parallel()
+	got := initialism.Suggest("future-brain-inc")
+	be.True(t, len(got) > 0)
+	be.Equal(t, got[0].Value, "FBI")
+	be.True(t, got[0].Collides())
+	be.Equal(t, got[0].Collisions, []initialism.Path{"far-beyond-insanity"})
+	be.True(t, got[0].Score < 100)
+
+	got = initialism.Suggest("the-dream-team")
+	be.True(t, !got[0].Collides())
+	be.Equal(t, got[0].Score, 100)
+}
+
+func TestSuggestScene(t *testing.T) {
+	t.Parallel()
+	got := initialism.Suggest("empire-of-pirate-intelligence-and-experts")
+	values := []string{}
+	for _, s := range got {
+		values = append(values, s.Value)
+	}
+	be.Equal(t, values, []string{"EPIE", "EPiE", "EOPiAE", "EOPIAE"})
+}
@@ -0,0 +1,136 @@
+package releaser
+
+import (
+	"strings"
+
+	"github.com/Defacto2/releaser/fix"
+	"github.com/Defacto2/releaser/name"
+)
+
+// KeyVersion is the version of the algorithm and data used by [Key].
+//
+// The key of a string is stable across patch releases of this module, so keys can be
+// stored in a database. Any change to the key algorithm, or any addition to the
+// special names, initialisms or aliases that changes an existing key, is only made
+// in a minor or major release and increments KeyVersion.
+// Stored keys should be recreated whenever the stored version differs from KeyVersion.
+const KeyVersion = 1
+
+// resolves are a cache of the URL paths keyed by their lowercased special names
+// and initialisms that is used by Key. Initialisms used by more than one releaser
+// are ambiguous and are not included, so the resolution is deterministic.
+var resolves = func() map[string]name.Path { //nolint:gochecknoglobals
+	m := make(map[string]name.Path)
+	ambiguous := make(map[string]bool)
+	for path, values := range *initialisms {
+		for _, value := range values {
+			v := strings.ToLower(value)
+			if p, found := m[v]; found && p != name.Path(path) {
+				ambiguous[v] = true
+				continue
+			}
+			m[v] = name.Path(path)
+		}
+	}
+	for v := range ambiguous {
+		delete(m, v)
+	}
+	for path, special := range *specials {
+		m[strings.ToLower(special)] = path
+	}
+	return m
+}()
+
+// Key returns the canonical comparison key of a releaser name, URL path,
+// special name or unique initialism. Strings that refer to the same releaser
+// return the same key, so the key can be used to find duplicates.
+//
+//   - The removal of decorations, spaced out letters and incompatible characters
+//   - The folding of case and diacritics
+//   - The resolution of special names, unique initialisms and obsolete URL paths
+//   - The splitting of joined-up names of known releasers
+//   - The removal of a leading "The" from every member of a cooperation, unless the
+//     names with and without "The" belong to different known releasers
+//   - The removal of all word separators
+//   - The dots of domain names and dotted acronyms are kept as the word "dot"
+//
+// Cooperation members are separated by an asterisk and are kept in their listed order,
+// and every member is resolved the same way as a single name.
+// If the string has no letters or numbers then an empty string is returned.
+// The key is stable, see [KeyVersion].
+//
+// Example:
+//
+//	Key("Razor 1911") = "razor1911"
+//	Key("RAZOR1911") = "razor1911"
+//	Key("The Razor 1911") = "razor1911"
+//	Key("razor-1911") = "razor1911"
+//	Key("TDT") = "thedreamteam"
+//	Key("Dream Team") = "dreamteam"
+//	Key("Razor 1911, TRSi") = "razor1911*trsi"
+//	Key("TDT, TRSi") = "thedreamteam*trsi"
+func Key(s string) string {
+	x := strings.TrimSpace(fold(fix.StripEndsKnown(s, known)))
+	p := keyPath(x)
+	members := []string{}
+	for member := range strings.SplitSeq(string(p), "*") {
+		words := strings.FieldsFunc(strings.ReplaceAll(member, ".", "-dot-"), separator)
+		if len(words) > 1 && words[0] == "the" && !distinct(name.Path(member)) {
+			words = words[1:]
+		}
+		if key := strings.Join(words, ""); key != "" {
+			members = append(members, key)
+		}
+	}
+	return strings.Join(members, "*")
+}
+
+// keyPath returns the URL path of the folded string that is used by [Key].
+// Special names and unique initialisms are resolved first, otherwise the string is
+// obfuscated and every member of a cooperation is resolved on its own.
+func keyPath(x string) name.Path {
+	if p, found := resolves[x]; found {
+		p, _ = name.Canonical(p)
+		return p
+	}
+	p := name.Path(x)
+	if !p.Valid() {
+		p = obfuscate(strings.ReplaceAll(x, "*", ", "))
+	}
+	if strings.Contains(string(p), "*") {
+		if canonical, _ := name.Canonical(p); canonical != p {
+			return canonical
+		}
+		members := []string{}
+		for member := range strings.SplitSeq(string(p), "*") {
+			members = append(members, string(keyPath(member)))
+		}
+		return name.Path(strings.Join(members, "*"))
+	}
+	if !paths[p] && strings.IndexFunc(string(p), separator) < 0 {
+		if joined, ok := Unjoin(string(p)); ok {
+			p = joined
+		}
+	}
+	p, _ = name.Canonical(p)
+	return p
+}
+
+// Equal returns true if both strings refer to the same releaser, using [Key] to compare them.
+// Strings without any letters or numbers are never equal.
+//
+// Example:
+//
+//	Equal("Razor 1911", "RAZOR1911") = true
+//	Equal("The Dream Team", "TDT") = true
+//	Equal("Razor 1911", "Razor 1911 Demo") = false
+func Equal(a, b string) bool {
+	x := Key(a)
+	return x != "" && x == Key(b)
+}
+
+// distinct returns true if the URL path that begins with "the-" and the URL path
+// without it both belong to known releasers, such as "the-dream-team" and "dream-team".
+func distinct(path name.Path) bool {
+	return paths[path] && paths[name.Path(strings.TrimPrefix(string(path), "the-"))]
+}
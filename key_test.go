@@ -0,0 +1,85 @@
+package releaser_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Defacto2/releaser"
+	"github.com/nalgeon/be"
+)
+
+func ExampleEqual() {
+	fmt.Println(releaser.Equal("Razor 1911", "RAZOR1911"))
+	fmt.Println(releaser.Equal("The Razor 1911", "razor-1911"))
+	fmt.Println(releaser.Equal("The Dream Team", "TDT"))
+	fmt.Println(releaser.Equal("Razor 1911", "Razor 1911 Demo"))
+	// Output: true
+	// true
+	// true
+	// false
+}
+
+// TestKey lists the keys that are stored by databases, so any change to
+// these results must increment releaser.KeyVersion.
+func TestKey(t *testing.T) {
+	t.Parallel()
+	be.Equal(t, releaser.KeyVersion, 1)
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{"", ""},
+		{"-=[ ]=-", ""},
+		{"Razor 1911", "razor1911"},
+		{"RAZOR1911", "razor1911"},
+		{"The Razor 1911", "razor1911"},
+		{"razor-1911", "razor1911"},
+		{"R A Z O R  1 9 1 1", "razor1911"},
+		{"-=xX Razor 1911 Xx=-", "razor1911"},
+		{"The Dream Team", "thedreamteam"},
+		{"thedreamteam", "thedreamteam"},
+		{"TDT", "thedreamteam"},
+		{"Dream Team", "dreamteam"},
+		{"DT", "dreamteam"},
+		{"fltdox", "fairlightdox"},
+		{"ACiD", "acidproductions"},
+		{"Ëclipse", "eclipse"},
+		{"Scene.org", "scenedotorg"},
+		{"scene-dot-org", "scenedotorg"},
+		{"The X BBS", "xbbs"},
+		{"Razor 1911, TRSi", "razor1911*trsi"},
+		{"razor-1911*the-dream-team", "razor1911*thedreamteam"},
+		{"TDT, TRSi", "thedreamteam*trsi"},
+		{"The Dream Team, TRSi", "thedreamteam*trsi"},
+		{"tdt*trsi", "thedreamteam*trsi"},
+		{"ACiD, thedreamteam", "acidproductions*thedreamteam"},
+		{"TDT / TRSi", "coop"},
+		{"coop", "coop"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+			be.Equal(t, releaser.Key(tt.s), tt.want)
+		})
+	}
+}
+
+func TestEqual(t *testing.T) {
+	t.Parallel()
+	be.True(t, releaser.Equal("Razor 1911", "razor-1911"))
+	be.True(t, releaser.Equal("TDT / TRSi", "coop"))
+	be.True(t, !releaser.Equal("The Dream Team", "Dream Team"))
+	be.True(t, !releaser.Equal("", ""))
+	be.True(t, !releaser.Equal("-=[ ]=-", "..."))
+	be.True(t, !releaser.Equal("Razor 1911, TRSi", "TRSi, Razor 1911"))
+	be.True(t, releaser.Equal("TDT, TRSi", "The Dream Team, TRSi"))
+}
+
+func TestKeyDeterministic(t *testing.T) {
+	t.Parallel()
+	// ambiguous initialisms must never resolve to a randomly chosen releaser
+	want := releaser.Key("RZR")
+	for range 50 {
+		be.Equal(t, releaser.Key("RZR"), want)
+	}
+}
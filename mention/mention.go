@@ -0,0 +1,286 @@
+// Package mention finds the mentions of known releasers within free text,
+// such as the content of NFO files, FILE_ID.DIZ descriptions and BBS adverts.
+//
+// Text using legacy code pages such as CP437 must be decoded to UTF-8 before it is searched.
+package mention
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/Defacto2/releaser"
+	"github.com/Defacto2/releaser/initialism"
+	"github.com/Defacto2/releaser/name"
+)
+
+// A Mention is a known releaser that was found in the text.
+type Mention struct {
+	Start int         // Start is the byte offset of the first character of the mention.
+	End   int         // End is the byte offset that follows the last character of the mention.
+	Text  string      // Text is the mention as it is written in the text.
+	Form  string      // Form is the styled name, humanized name or initialism that was matched.
+	Paths []name.Path // Paths are the candidate URL paths of the releasers.
+}
+
+// short is the maximum number of characters in a form that must match with case.
+// Short forms are often common words, so they are only matched when the text
+// uses the same casing as the form or is written in all uppercase.
+const short = 3
+
+// A pattern is a form that is searched for.
+type pattern struct {
+	form   string
+	runes  int
+	strict bool // strict is true if the form must match with case, see [strict].
+	paths  []name.Path
+}
+
+// A node is a state of the Aho-Corasick automaton.
+type node struct {
+	next    map[rune]int
+	fail    int
+	outputs []int // outputs are the indexes of the patterns that end at this node.
+}
+
+// A Matcher is a multi-pattern matcher that finds the known releasers in text.
+// It is safe for concurrent use.
+type Matcher struct {
+	patterns []pattern
+	nodes    []node
+}
+
+// New returns a matcher built from every styled name, humanized URL path and initialism.
+func New() *Matcher {
+	forms := make(map[string]*pattern)
+	keys := []string{}
+	add := func(form string, path name.Path) {
+		form = strings.Join(strings.Fields(form), " ")
+		if utf8.RuneCountInString(form) < 2 || strings.IndexFunc(form, unicode.IsLetter) < 0 {
+			return
+		}
+		key := fold(form)
+		p, found := forms[key]
+		if !found {
+			p = &pattern{form: form, runes: utf8.RuneCountInString(key), strict: strict(form)}
+			forms[key] = p
+			keys = append(keys, key)
+		}
+		if !slices.Contains(p.paths, path) {
+			p.paths = append(p.paths, path)
+		}
+	}
+	specials := *name.Special()
+	for _, path := range slices.Sorted(maps.Keys(specials)) {
+		add(specials[path], path)
+	}
+	inits := *initialism.Initialisms()
+	for _, path := range slices.Sorted(maps.Keys(inits)) {
+		add(releaser.Humanize(string(path)), name.Path(path))
+	}
+	for _, path := range slices.Sorted(maps.Keys(inits)) {
+		for _, value := range inits[path] {
+			add(value, name.Path(path))
+		}
+	}
+	m := &Matcher{nodes: []node{{next: map[rune]int{}}}}
+	for _, key := range keys {
+		p := forms[key]
+		slices.Sort(p.paths)
+		m.insert(key, len(m.patterns))
+		m.patterns = append(m.patterns, *p)
+	}
+	m.link()
+	return m
+}
+
+// insert adds the folded key of a pattern to the trie.
+func (m *Matcher) insert(key string, id int) {
+	state := 0
+	for _, r := range key {
+		next, found := m.nodes[state].next[r]
+		if !found {
+			next = len(m.nodes)
+			m.nodes = append(m.nodes, node{next: map[rune]int{}})
+			m.nodes[state].next[r] = next
+		}
+		state = next
+	}
+	m.nodes[state].outputs = append(m.nodes[state].outputs, id)
+}
+
+// link builds the failure links of the automaton using a breadth-first walk of the trie.
+func (m *Matcher) link() {
+	queue := []int{}
+	for _, child := range m.nodes[0].next {
+		queue = append(queue, child)
+	}
+	for len(queue) > 0 {
+		state := queue[0]
+		queue = queue[1:]
+		for r, child := range m.nodes[state].next {
+			queue = append(queue, child)
+			fail := m.nodes[state].fail
+			for {
+				if next, found := m.nodes[fail].next[r]; found && next != child {
+					m.nodes[child].fail = next
+					break
+				}
+				if fail == 0 {
+					m.nodes[child].fail = 0
+					break
+				}
+				fail = m.nodes[fail].fail
+			}
+			m.nodes[child].outputs = append(m.nodes[child].outputs, m.nodes[m.nodes[child].fail].outputs...)
+		}
+	}
+}
+
+// fold returns the case folded string that is used to match the text.
+func fold(s string) string {
+	return strings.Map(unicode.ToLower, s)
+}
+
+// Find returns the mentions of known releasers in the text, in order of appearance.
+// Mentions must start and end on a word boundary, so "ACE" is not found within "SPACE".
+// Overlapping mentions are resolved in favor of the earliest and then the longest match.
+// Any runs of whitespace in the text are treated as a single space.
+func (m *Matcher) Find(text string) []Mention {
+	type fed struct {
+		offset int
+		r      rune
+	}
+	feeds := []fed{}
+	found := []Mention{}
+	state := 0
+	space := false
+	for offset, r := range text {
+		if unicode.IsSpace(r) {
+			if space {
+				continue
+			}
+			space, r = true, ' '
+		} else {
+			space = false
+		}
+		feeds = append(feeds, fed{offset: offset, r: r})
+		r = unicode.ToLower(r)
+		for {
+			if next, ok := m.nodes[state].next[r]; ok {
+				state = next
+				break
+			}
+			if state == 0 {
+				break
+			}
+			state = m.nodes[state].fail
+		}
+		for _, id := range m.nodes[state].outputs {
+			p := m.patterns[id]
+			first := len(feeds) - p.runes
+			if first < 0 {
+				continue
+			}
+			start := feeds[first].offset
+			end := offset + utf8.RuneLen(feeds[len(feeds)-1].r)
+			if !boundary(text, start, end) {
+				continue
+			}
+			if !cased(text[start:end], p) {
+				continue
+			}
+			found = append(found, Mention{
+				Start: start, End: end, Text: text[start:end],
+				Form: p.form, Paths: slices.Clone(p.paths),
+			})
+		}
+	}
+	return longest(found)
+}
+
+// boundary returns true if the characters either side of the text[start:end] are not letters or digits.
+func boundary(text string, start, end int) bool {
+	if start > 0 {
+		r, _ := utf8.DecodeLastRuneInString(text[:start])
+		if word(r) {
+			return false
+		}
+	}
+	if end < len(text) {
+		r, _ := utf8.DecodeRuneInString(text[end:])
+		if word(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func word(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// strict returns true if the form must be written with the same casing or in all uppercase.
+// Short forms, single words such as "Epic" and styled names such as "SKiLL" or "CORE"
+// are often dictionary words, so only names of multiple plainly cased words, such as
+// "Razor 1911", are matched without case.
+func strict(form string) bool {
+	if utf8.RuneCountInString(form) <= short || !strings.Contains(form, " ") {
+		return true
+	}
+	for w := range strings.FieldsSeq(form) {
+		_, size := utf8.DecodeRuneInString(w)
+		if strings.IndexFunc(w[size:], unicode.IsUpper) >= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// cased returns true if the text matches the casing of a strict form or is written in all uppercase.
+func cased(s string, p pattern) bool {
+	if !p.strict {
+		return true
+	}
+	return s == p.form || s == strings.ToUpper(s)
+}
+
+// longest returns the earliest and longest mentions that do not overlap.
+func longest(found []Mention) []Mention {
+	slices.SortStableFunc(found, func(a, b Mention) int {
+		if c := cmp.Compare(a.Start, b.Start); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.End, a.End)
+	})
+	keep := []Mention{}
+	end := 0
+	for _, m := range found {
+		if m.Start < end {
+			continue
+		}
+		keep = append(keep, m)
+		end = m.End
+	}
+	return keep
+}
+
+// matcher is the lazily built matcher that is used by Find.
+var matcher = sync.OnceValue(New) //nolint:gochecknoglobals
+
+// Find returns the mentions of known releasers in the text using the default matcher.
+//
+// Example:
+//
+//	Find("Cracked by RZR, greets to TDT and Fairlight") = []Mention{
+//		{Start: 11, End: 14, Text: "RZR", Form: "RZR", Paths: []name.Path{"razor-1911", "razor-1911-demo", "razordox"}},
+//		{Start: 26, End: 29, Text: "TDT", Form: "TDT", Paths: []name.Path{"the-dream-team"}},
+//		{Start: 34, End: 43, Text: "Fairlight", Form: "Fairlight", Paths: []name.Path{"fairlight"}},
+//	}
+func Find(text string) []Mention {
+	return matcher().Find(text)
+}
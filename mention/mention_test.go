@@ -0,0 +1,82 @@
+package mention_test
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/Defacto2/releaser/mention"
+	"github.com/Defacto2/releaser/name"
+	"github.com/nalgeon/be"
+)
+
+func ExampleFind() {
+	const nfo = "Cracked by RZR, greets to TDT and Fairlight"
+	for _, m := range mention.Find(nfo) {
+		fmt.Println(m.Start, m.End, m.Text, len(m.Paths))
+	}
+	// Output: 11 14 RZR 3
+	// 26 29 TDT 1
+	// 34 43 Fairlight 1
+}
+
+func BenchmarkFind(b *testing.B) {
+	const nfo = "═══╣ RAZOR 1911 ╠═══ Cracked by RZR, greets to TDT, TRSi and Fairlight"
+	m := mention.New()
+	for b.Loop() {
+		fmt.Fprintln(io.Discard, m.Find(nfo))
+	}
+}
+
+func TestFind(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		text  string
+		texts []string
+		forms []string
+	}{
+		{"empty", "", []string{}, []string{}},
+		{"no mentions", "Install it and run setup.", []string{}, []string{}},
+		{"word boundary", "SPACE and ACEs", []string{}, []string{}},
+		{"initialism", "SPACE ACE", []string{"ACE"}, []string{"ACE"}},
+		{"short lowercase", "an ace of spades", []string{}, []string{}},
+		{"short styled", "iCE and ICE", []string{"iCE", "ICE"}, []string{"iCE", "iCE"}},
+		{"case folded", "RAZOR 1911 rules", []string{"RAZOR 1911"}, []string{"Razor 1911"}},
+		{"whitespace", "Razor\t  1911", []string{"Razor\t  1911"}, []string{"Razor 1911"}},
+		{"longest", "The Dream Team", []string{"The Dream Team"}, []string{"The Dream Team"}},
+		{"styled", "ACiD Productions", []string{"ACiD Productions"}, []string{"ACiD Productions"}},
+		{"cp437 frame", "═══╣RAZOR 1911╠═══", []string{"RAZOR 1911"}, []string{"Razor 1911"}},
+		{"accented", "Pouët!", []string{"Pouët"}, []string{"Pouët"}},
+		{
+			"prose",
+			"This is an epic release. Skill level: high. It is the core of the scene.",
+			[]string{}, []string{},
+		},
+		{"styled exact", "EPiC, SKiLL and CORE", []string{"EPiC", "SKiLL", "CORE"}, []string{"EPiC", "SKiLL", "CORE"}},
+		{"styled uppercase", "EPIC", []string{"EPIC"}, []string{"EPiC"}},
+		{"styled words", "acid productions", []string{}, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := mention.Find(tt.text)
+			texts, forms := []string{}, []string{}
+			for _, m := range got {
+				be.Equal(t, tt.text[m.Start:m.End], m.Text)
+				be.True(t, len(m.Paths) > 0)
+				texts = append(texts, m.Text)
+				forms = append(forms, m.Form)
+			}
+			be.Equal(t, texts, tt.texts)
+			be.Equal(t, forms, tt.forms)
+		})
+	}
+}
+
+func TestFind_paths(t *testing.T) {
+	t.Parallel()
+	got := mention.Find("greets to RZR")
+	be.Equal(t, len(got), 1)
+	be.Equal(t, got[0].Paths, []name.Path{"razor-1911", "razor-1911-demo", "razordox"})
+}
@@ -0,0 +1,297 @@
+// Package migrate compares the releaser values of a database export with their
+// current [releaser.Cell] and [releaser.Index] forms, so the rows saved using
+// earlier rules can be fixed with reviewable SQL UPDATE statements or a CSV diff.
+package migrate
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/Defacto2/releaser"
+	"github.com/Defacto2/releaser/fix"
+	"github.com/Defacto2/releaser/initialism"
+	"github.com/Defacto2/releaser/name"
+)
+
+var (
+	// ErrColumn is returned when a column position is missing from a record of the export.
+	ErrColumn = errors.New("the column is out of range")
+	// ErrName is returned when a column has no name and the export has no header to name it.
+	ErrName = errors.New("the column has no name")
+)
+
+// A Form is the way a releaser value is stored in a database column.
+type Form uint8
+
+// The stored forms of a releaser value.
+const (
+	Cell  Form = iota // Cell is the form returned by releaser.Cell, e.g. "RAZOR 1911".
+	Index             // Index is the form returned by releaser.Index for the URL path of the value.
+)
+
+// String returns the name of the form.
+func (f Form) String() string {
+	switch f {
+	case Cell:
+		return "cell"
+	case Index:
+		return "index"
+	}
+	return ""
+}
+
+// Apply returns the value in its current stored form.
+//
+// Example:
+//
+//	Cell.Apply("-=xX Razor 1911 Xx=-") = "RAZOR 1911"
+//	Index.Apply("razor 1911") = "RAZOR 1911"
+func (f Form) Apply(value string) string {
+	switch f {
+	case Cell:
+		return releaser.Cell(value)
+	case Index:
+		return reindex(value)
+	}
+	return value
+}
+
+// reindex returns the stored index key, or any humanized name, as the index key of its URL path.
+// Unlike releaser.Obfuscate, the initialisms are never looked up, as a stored key is a name and an
+// initialism can belong to more than one releaser. Special names are matched first and
+// everything else is cleaned into a URL path, so reindexing an index key never changes it.
+func reindex(key string) string {
+	s := strings.TrimSpace(fix.StripEndsKnown(key, known))
+	return releaser.Index(string(path(s)))
+}
+
+// path returns the URL path of the humanized name without looking up any initialisms.
+func path(s string) name.Path {
+	specials := *name.Special()
+	match := name.Path("")
+	for _, uri := range slices.Sorted(maps.Keys(specials)) {
+		if !strings.EqualFold(s, specials[uri]) {
+			continue
+		}
+		// the special name can also be the index key of another releaser, such as "2000AD"
+		if strings.EqualFold(s, releaser.Index(string(uri))) {
+			return uri
+		}
+		if match == "" {
+			match = uri
+		}
+	}
+	if match != "" && !strings.EqualFold(s, releaser.Index(string(clean(s)))) {
+		return match
+	}
+	i := strings.LastIndex(s, "#")
+	if strings.HasSuffix(s, ")") {
+		i = strings.LastIndex(s, "(")
+	}
+	if word, ok := name.Qualify(s[max(i, 0):]); ok && i > 0 {
+		if p := path(strings.TrimSpace(s[:i])); p != "" && !strings.Contains(string(p), "*") {
+			return p + name.Path("-"+word)
+		}
+	}
+	return clean(s)
+}
+
+// clean returns the cleaned name as a URL path without looking up any names.
+func clean(s string) name.Path {
+	x := fix.Collapse(s)
+	x = fix.StripChars(x)
+	x = fix.TrimThe(x)
+	x = fix.TrimSP(x)
+	return name.Obfuscate(x)
+}
+
+// knowns are the lowercased special names and initialisms, which are kept whole by reindex.
+var knowns = sync.OnceValue(func() map[string]bool { //nolint:gochecknoglobals
+	m := make(map[string]bool)
+	for _, special := range *name.Special() {
+		m[strings.ToLower(special)] = true
+	}
+	for _, values := range *initialism.Initialisms() {
+		for _, value := range values {
+			m[strings.ToLower(value)] = true
+		}
+	}
+	return m
+})
+
+// known returns true if the string is a known special name, initialism, acronym or alternative spelling.
+func known(s string) bool {
+	return knowns()[strings.ToLower(s)]
+}
+
+// A Column is a releaser column of the export.
+type Column struct {
+	Index int    // Index is the zero-based position of the column in the records.
+	Name  string // Name is the name of the database column, which defaults to the header of the export.
+	Form  Form   // Form is the way the releaser value is stored in the column.
+}
+
+// Options are the layout of the export.
+type Options struct {
+	Comma   rune     // Comma is the field delimiter, which defaults to a comma. Use '\t' for TSV.
+	Header  bool     // Header is true if the first record contains the column names.
+	ID      int      // ID is the zero-based position of the id column.
+	IDName  string   // IDName is the name of the id column, which defaults to the header of the export.
+	Columns []Column // Columns are the releaser columns to compare.
+}
+
+// A Change is a stored value that differs from its current form.
+type Change struct {
+	Line   int    // Line is the line number of the record in the export.
+	ID     string // ID is the id of the row.
+	Column string // Column is the name of the database column.
+	Old    string // Old is the stored value.
+	New    string // New is the value in its current form, which is empty if the value cannot be formatted.
+}
+
+// A Diff is the changes of an export.
+type Diff struct {
+	IDName  string   // IDName is the name of the id column.
+	Changes []Change // Changes are the values that differ from their current form.
+}
+
+// Compare reads the export and returns the values that differ from their current form,
+// in the order of the records and columns.
+// An error is returned if a record is missing a column,
+// or if a column has no name and the export has no header.
+func Compare(r io.Reader, opts Options) (Diff, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+	if opts.Comma != 0 {
+		cr.Comma = opts.Comma
+	}
+	columns := append([]Column{}, opts.Columns...)
+	idName := opts.IDName
+	changes := []Change{}
+	for line := 1; ; line++ {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return Diff{}, fmt.Errorf("migrate compare: %w", err)
+		}
+		if err := inRange(record, line, opts.ID, columns); err != nil {
+			return Diff{}, err
+		}
+		if line == 1 && opts.Header {
+			if idName == "" {
+				idName = strings.TrimSpace(record[opts.ID])
+			}
+			for i, c := range columns {
+				if c.Name == "" {
+					columns[i].Name = strings.TrimSpace(record[c.Index])
+				}
+			}
+			continue
+		}
+		for _, c := range columns {
+			if c.Name == "" {
+				return Diff{}, fmt.Errorf("%w: column %d", ErrName, c.Index)
+			}
+			old := record[c.Index]
+			if old == "" {
+				continue
+			}
+			if current := c.Form.Apply(old); current != old {
+				changes = append(changes, Change{
+					Line: line, ID: strings.TrimSpace(record[opts.ID]), Column: c.Name, Old: old, New: current,
+				})
+			}
+		}
+	}
+	if idName == "" {
+		return Diff{}, fmt.Errorf("%w: id column %d", ErrName, opts.ID)
+	}
+	return Diff{IDName: idName, Changes: changes}, nil
+}
+
+// inRange returns an error if the id or any of the columns are not in the record.
+func inRange(record []string, line, id int, columns []Column) error {
+	if id < 0 || id >= len(record) {
+		return fmt.Errorf("%w: id column %d of line %d", ErrColumn, id, line)
+	}
+	for _, c := range columns {
+		if c.Index < 0 || c.Index >= len(record) {
+			return fmt.Errorf("%w: column %d of line %d", ErrColumn, c.Index, line)
+		}
+	}
+	return nil
+}
+
+// WriteSQL writes the changes as SQL UPDATE statements of the table within a transaction.
+// Each statement is preceded by a comment of the export line and the stored value.
+// Changes without a new value would empty the column, so they are written as comments for review.
+// Numeric ids are written unquoted.
+func (d Diff) WriteSQL(w io.Writer, table string) error {
+	var b strings.Builder
+	b.WriteString("BEGIN;\n")
+	for _, c := range d.Changes {
+		fmt.Fprintf(&b, "-- line %d: %s\n", c.Line, strconv.Quote(c.Old))
+		update := fmt.Sprintf("UPDATE %s SET %s = %s WHERE %s = %s;",
+			Identifier(table), Identifier(c.Column), Literal(c.New), Identifier(d.IDName), id(c.ID))
+		if c.New == "" {
+			b.WriteString("-- skipped, the value cannot be formatted: ")
+		}
+		b.WriteString(update + "\n")
+	}
+	b.WriteString("COMMIT;\n")
+	_, err := io.WriteString(w, b.String())
+	if err != nil {
+		return fmt.Errorf("migrate write sql: %w", err)
+	}
+	return nil
+}
+
+// WriteCSV writes the changes as a CSV diff with a header record of line, id, column, old and new.
+func (d Diff) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"line", "id", "column", "old", "new"})
+	for _, c := range d.Changes {
+		_ = cw.Write([]string{strconv.Itoa(c.Line), c.ID, c.Column, c.Old, c.New})
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("migrate write csv: %w", err)
+	}
+	return nil
+}
+
+// Identifier returns the SQL identifier in double quotes, with any double quotes doubled.
+//
+// Example:
+//
+//	Identifier("group_brand_for") = `"group_brand_for"`
+func Identifier(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
+
+// Literal returns the SQL string literal in single quotes, with any single quotes doubled.
+//
+// Example:
+//
+//	Literal("DEVIL'S REALM") = `'DEVIL''S REALM'`
+func Literal(s string) string {
+	return `'` + strings.ReplaceAll(s, `'`, `''`) + `'`
+}
+
+// id returns the id as a SQL number if it is an integer, otherwise as a string literal.
+func id(s string) string {
+	if _, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return s
+	}
+	return Literal(s)
+}
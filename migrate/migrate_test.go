@@ -0,0 +1,149 @@
+package migrate_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Defacto2/releaser"
+	"github.com/Defacto2/releaser/initialism"
+	"github.com/Defacto2/releaser/migrate"
+	"github.com/Defacto2/releaser/name"
+	"github.com/nalgeon/be"
+)
+
+const export = "id,group_brand_for,group_index\n" +
+	"1,RAZOR 1911,RAZOR 1911\n" +
+	"2,-=xX Razor 1911 Xx=-,razor 1911\n" +
+	"3,Devil's Realm BBS,\n" +
+	"4,-=[]=-,\n"
+
+func ExampleDiff_WriteSQL() {
+	opts := migrate.Options{
+		Header:  true,
+		Columns: []migrate.Column{{Index: 1, Form: migrate.Cell}},
+	}
+	diff, err := migrate.Compare(strings.NewReader(export), opts)
+	if err != nil {
+		panic(err)
+	}
+	if err := diff.WriteSQL(os.Stdout, "groups"); err != nil {
+		panic(err)
+	}
+	// Output: BEGIN;
+	// -- line 3: "-=xX Razor 1911 Xx=-"
+	// UPDATE "groups" SET "group_brand_for" = 'RAZOR 1911' WHERE "id" = 2;
+	// -- line 4: "Devil's Realm BBS"
+	// UPDATE "groups" SET "group_brand_for" = 'DEVILS REALM BBS' WHERE "id" = 3;
+	// -- line 5: "-=[]=-"
+	// -- skipped, the value cannot be formatted: UPDATE "groups" SET "group_brand_for" = '' WHERE "id" = 4;
+	// COMMIT;
+}
+
+func TestForm(t *testing.T) {
+	t.Parallel()
+	be.Equal(t, migrate.Cell.String(), "cell")
+	be.Equal(t, migrate.Index.String(), "index")
+	be.Equal(t, migrate.Form(99).String(), "")
+	be.Equal(t, migrate.Cell.Apply("-=xX Razor 1911 Xx=-"), "RAZOR 1911")
+	be.Equal(t, migrate.Index.Apply("razor 1911"), "RAZOR 1911")
+	be.Equal(t, migrate.Form(99).Apply("razor 1911"), "razor 1911")
+	// stored index keys that are also initialisms of other releasers are kept
+	for _, key := range []string{
+		"CORRUPTION", "DVT", "MANIFEST", "FATAL", "RPM BBS", "REVOLUTIONS PER MINUTE BBS", "AIR",
+	} {
+		be.Equal(t, migrate.Index.Apply(key), key)
+	}
+	be.Equal(t, migrate.Index.Apply("tdt / trsi"), "TDT / TRSI")
+	be.Equal(t, migrate.Index.Apply("unknown group nj"), "UNKNOWN GROUP NJ")
+	be.Equal(t, migrate.Index.Apply("team 17"), "TEAM 17")
+	be.Equal(t, migrate.Index.Apply("-=xX Razor 1911 Xx=-"), "RAZOR 1911")
+	be.Equal(t, migrate.Index.Apply("IMAGE (NJ)"), "IMAGE NJ")
+	be.Equal(t, migrate.Index.Apply("SCORPION ¥"), "SCORPION")
+	be.Equal(t, migrate.Index.Apply("2000 AD"), "2000 AD")
+	be.Equal(t, migrate.Index.Apply("CLASS, PARADIGM, RAZOR 1911"), "CLASS, PARADIGM, RAZOR 1911")
+	be.Equal(t, migrate.Index.Apply(""), "")
+}
+
+func TestIndexIdempotent(t *testing.T) {
+	t.Parallel()
+	// applying the index form to a stored index key must never change it
+	paths := []string{}
+	for path := range *name.Special() {
+		paths = append(paths, string(path))
+	}
+	for path := range *initialism.Initialisms() {
+		paths = append(paths, string(path))
+	}
+	for _, path := range paths {
+		key := releaser.Index(path)
+		if got := migrate.Index.Apply(key); got != key {
+			t.Errorf("%s: Apply(%q) = %q", path, key, got)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+	opts := migrate.Options{
+		Header: true,
+		Columns: []migrate.Column{
+			{Index: 1, Form: migrate.Cell},
+			{Index: 2, Name: "idx", Form: migrate.Index},
+		},
+	}
+	diff, err := migrate.Compare(strings.NewReader(export), opts)
+	be.Err(t, err, nil)
+	be.Equal(t, diff.IDName, "id")
+	be.Equal(t, len(diff.Changes), 4)
+	be.Equal(t, diff.Changes[1], migrate.Change{
+		Line: 3, ID: "2", Column: "idx", Old: "razor 1911", New: "RAZOR 1911",
+	})
+
+	tsv := strings.ReplaceAll(export, ",", "\t")
+	opts.Comma = '\t'
+	diff, err = migrate.Compare(strings.NewReader(tsv), opts)
+	be.Err(t, err, nil)
+	be.Equal(t, len(diff.Changes), 4)
+}
+
+func TestCompareErrors(t *testing.T) {
+	t.Parallel()
+	cols := []migrate.Column{{Index: 1, Form: migrate.Cell}}
+	_, err := migrate.Compare(strings.NewReader(export), migrate.Options{Columns: cols})
+	be.True(t, errors.Is(err, migrate.ErrName))
+	_, err = migrate.Compare(strings.NewReader(export), migrate.Options{
+		Header: true, Columns: []migrate.Column{{Index: 5}},
+	})
+	be.True(t, errors.Is(err, migrate.ErrColumn))
+	_, err = migrate.Compare(strings.NewReader(export), migrate.Options{Header: true, ID: -1})
+	be.True(t, errors.Is(err, migrate.ErrColumn))
+	diff, err := migrate.Compare(strings.NewReader(""), migrate.Options{IDName: "id", Columns: cols})
+	be.Err(t, err, nil)
+	be.Equal(t, len(diff.Changes), 0)
+}
+
+func TestWriteCSV(t *testing.T) {
+	t.Parallel()
+	diff := migrate.Diff{IDName: "id", Changes: []migrate.Change{
+		{Line: 2, ID: "7", Column: "group_brand_for", Old: "Razor, 1911", New: "RAZOR, 1911"},
+	}}
+	var b bytes.Buffer
+	be.Err(t, diff.WriteCSV(&b), nil)
+	be.Equal(t, b.String(), "line,id,column,old,new\n2,7,group_brand_for,\"Razor, 1911\",\"RAZOR, 1911\"\n")
+}
+
+func TestSQL(t *testing.T) {
+	t.Parallel()
+	be.Equal(t, migrate.Identifier(`a"b`), `"a""b"`)
+	be.Equal(t, migrate.Literal("DEVIL'S REALM"), `'DEVIL''S REALM'`)
+	diff := migrate.Diff{IDName: "uuid", Changes: []migrate.Change{
+		{Line: 2, ID: "a1-b2", Column: "x", Old: "o'ld\n", New: "NEW"},
+	}}
+	var b bytes.Buffer
+	be.Err(t, diff.WriteSQL(&b, "t"), nil)
+	be.Equal(t, b.String(), "BEGIN;\n-- line 2: \"o'ld\\n\"\n"+
+		"UPDATE \"t\" SET \"x\" = 'NEW' WHERE \"uuid\" = 'a1-b2';\nCOMMIT;\n")
+}
@@ -0,0 +1,75 @@
+package name
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// An Alias is a map of obsolete URL paths and their canonical replacements.
+type Alias map[Path]Path
+
+// Aliases returns the list of obsolete URL paths that have been replaced.
+// The keys are historic paths that contained typos or belong to renamed groups,
+// and the values are the canonical paths that should be used instead.
+//
+// Fixing a misspelled path is done by renaming the key in the [initialism] or
+// name lists and adding the original path here, so existing URLs do not break.
+//
+// [initialism]: https://github.com/Defacto2/releaser/initialism
+func Aliases() *Alias {
+	list := Alias{
+		"hamburger-heavan-bbs":                  "hamburger-heaven-bbs",
+		"necropedophillic-anti_social-imbecils": "necropedophilic-anti_social-imbeciles",
+	}
+	return &list
+}
+
+// aliases is a cache of the obsolete URL paths that is used by Canonical.
+var aliases = *Aliases() //nolint:gochecknoglobals
+
+// Canonical returns the canonical URL path for the path and reports whether
+// a redirect applies. A redirect applies when the path is an obsolete alias
+// or when the path uses uppercase characters.
+//
+// Example:
+//
+//	Canonical("hamburger-heavan-bbs") = "hamburger-heaven-bbs", true
+//	Canonical("Razor-1911") = "razor-1911", true
+//	Canonical("razor-1911") = "razor-1911", false
+func Canonical(path Path) (Path, bool) {
+	p := Path(strings.ToLower(string(path)))
+	if canonical, match := aliases[p]; match {
+		return canonical, true
+	}
+	return p, p != path
+}
+
+// RedirectPrefix is the URL path prefix of the releaser pages.
+const RedirectPrefix = "/g/"
+
+// Redirect is a net/http middleware that responds with a 301 permanent redirect
+// whenever the request is for a non-canonical releaser URL path, /g/{path}.
+// Query strings are kept. All other requests are passed on to the next handler.
+//
+// Example:
+//
+//	mux := http.NewServeMux()
+//	mux.HandleFunc("/g/{path}", handler)
+//	http.ListenAndServe(":8080", name.Redirect(mux))
+func Redirect(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s, found := strings.CutPrefix(r.URL.Path, RedirectPrefix)
+		if !found || s == "" || strings.Contains(s, "/") {
+			next.ServeHTTP(w, r)
+			return
+		}
+		canonical, redirect := Canonical(Path(s))
+		if !redirect {
+			next.ServeHTTP(w, r)
+			return
+		}
+		u := url.URL{Path: RedirectPrefix + string(canonical), RawQuery: r.URL.RawQuery}
+		http.Redirect(w, r, u.String(), http.StatusMovedPermanently)
+	})
+}
@@ -0,0 +1,93 @@
+package name_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Defacto2/releaser/initialism"
+	"github.com/Defacto2/releaser/name"
+	"github.com/nalgeon/be"
+)
+
+func ExampleCanonical() {
+	path, redirect := name.Canonical("hamburger-heavan-bbs")
+	fmt.Println(string(path), redirect)
+
+	path, redirect = name.Canonical("razor-1911")
+	fmt.Println(string(path), redirect)
+	// Output: hamburger-heaven-bbs true
+	// razor-1911 false
+}
+
+func TestAliases(t *testing.T) {
+	t.Parallel()
+	// confirm all aliases are valid and point to a listed path
+	known := *initialism.Initialisms()
+	for alias, canonical := range *name.Aliases() {
+		be.True(t, alias.Valid())
+		be.True(t, canonical.Valid())
+		be.True(t, alias != canonical)
+		_, isInit := known[initialism.Path(canonical)]
+		_, isName := (*name.Special())[canonical]
+		be.True(t, isInit || isName)
+		_, stale := known[initialism.Path(alias)]
+		be.Equal(t, stale, false)
+	}
+}
+
+func TestCanonical(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		path     name.Path
+		want     name.Path
+		redirect bool
+	}{
+		{"empty", "", "", false},
+		{"canonical", "razor-1911", "razor-1911", false},
+		{"uppercase", "Razor-1911", "razor-1911", true},
+		{"alias", "hamburger-heavan-bbs", "hamburger-heaven-bbs", true},
+		{"alias uppercase", "HAMBURGER-HEAVAN-BBS", "hamburger-heaven-bbs", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, redirect := name.Canonical(tt.path)
+			be.Equal(t, got, tt.want)
+			be.Equal(t, redirect, tt.redirect)
+		})
+	}
+}
+
+func TestRedirect(t *testing.T) {
+	t.Parallel()
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := name.Redirect(next)
+	tests := []struct {
+		name     string
+		target   string
+		code     int
+		location string
+	}{
+		{"canonical", "/g/razor-1911", http.StatusOK, ""},
+		{"other route", "/f/hamburger-heavan-bbs", http.StatusOK, ""},
+		{"sub route", "/g/hamburger-heavan-bbs/about", http.StatusOK, ""},
+		{"alias", "/g/hamburger-heavan-bbs", http.StatusMovedPermanently, "/g/hamburger-heaven-bbs"},
+		{"uppercase", "/g/Razor-1911", http.StatusMovedPermanently, "/g/razor-1911"},
+		{"query", "/g/hamburger-heavan-bbs?page=2", http.StatusMovedPermanently, "/g/hamburger-heaven-bbs?page=2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			be.Equal(t, w.Code, tt.code)
+			be.Equal(t, w.Header().Get("Location"), tt.location)
+		})
+	}
+}
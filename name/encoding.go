@@ -0,0 +1,179 @@
+package name
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+)
+
+// ErrScan is returned when a database value is not a string or bytes, such as a NULL
+// that should be scanned into a [NullPath].
+var ErrScan = errors.New("the path cannot be scanned from the database type")
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+// The zero value is encoded as empty text, and an invalid URL path returns a [ValidationError].
+func (path Path) MarshalText() ([]byte, error) {
+	if path == "" {
+		return []byte{}, nil
+	}
+	if err := path.Validate(); err != nil {
+		return nil, err
+	}
+	return []byte(path), nil
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// Empty text is decoded as the zero value. Otherwise the text is normalized using [Normalize],
+// and a URL path that is still invalid returns a [ValidationError] and leaves the path unchanged.
+//
+// Example:
+//
+//	UnmarshalText([]byte("The-X-BBS")) = Path("x-bbs"), nil
+//	UnmarshalText([]byte("")) = Path(""), nil
+//	UnmarshalText([]byte("razor#1911")) = &ValidationError{Rune: '#', Offset: 5, Rule: RuleCharacter}
+func (path *Path) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*path = ""
+		return nil
+	}
+	p, err := Normalize(Path(text))
+	if err != nil {
+		return err
+	}
+	*path = p
+	return nil
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The URL path is encoded as a JSON string, the zero value as an empty string,
+// and an invalid URL path returns a [ValidationError].
+func (path Path) MarshalJSON() ([]byte, error) {
+	if path == "" {
+		return []byte(`""`), nil
+	}
+	if err := path.Validate(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(path)) //nolint:wrapcheck
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// The JSON string is decoded using [Path.UnmarshalText], and a JSON null is ignored.
+func (path *Path) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("path unmarshal json: %w", err)
+	}
+	return path.UnmarshalText([]byte(s))
+}
+
+// Scan implements the [database/sql.Scanner] interface for string and []byte column values.
+// The value is decoded using [Path.UnmarshalText], so an empty value is the zero value.
+// Use [NullPath] for columns that can be NULL.
+func (path *Path) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		return path.UnmarshalText([]byte(v))
+	case []byte:
+		return path.UnmarshalText(v)
+	}
+	return fmt.Errorf("%w: %T", ErrScan, src)
+}
+
+// Value implements the [database/sql/driver.Valuer] interface.
+// The zero value is stored as an empty string, and an invalid URL path returns a [ValidationError].
+func (path Path) Value() (driver.Value, error) {
+	if path == "" {
+		return "", nil
+	}
+	if err := path.Validate(); err != nil {
+		return nil, err
+	}
+	return string(path), nil
+}
+
+// LogValue implements the [log/slog.LogValuer] interface.
+// The URL path is logged as a group of the path and, for the well-known releasers,
+// their styled name. Other paths are logged without a name, as the [Humanize] name
+// is only lowercased and the styled name needs the releaser package.
+//
+// Example:
+//
+//	slog.Info("releaser", "group", Path("acid-productions")) = `group.path=acid-productions group.name="ACiD Productions"`
+//	slog.Info("releaser", "group", Path("razor-1911")) = `group.path=razor-1911`
+func (path Path) LogValue() slog.Value {
+	if s := path.String(); s != "" {
+		return slog.GroupValue(
+			slog.String("path", string(path)),
+			slog.String("name", s),
+		)
+	}
+	return slog.GroupValue(slog.String("path", string(path)))
+}
+
+// A NullPath is a URL path that may be null, for use with database columns
+// and JSON values that can be NULL. It is similar to [database/sql.NullString].
+type NullPath struct {
+	Path  Path // Path is the URL path, which is only used when Valid is true.
+	Valid bool // Valid is true if the URL path is not NULL.
+}
+
+// Scan implements the [database/sql.Scanner] interface.
+// A NULL value sets Valid to false, otherwise the value is decoded using [Path.Scan].
+func (n *NullPath) Scan(src any) error {
+	if src == nil {
+		n.Path, n.Valid = "", false
+		return nil
+	}
+	if err := n.Path.Scan(src); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements the [database/sql/driver.Valuer] interface.
+// A path that is not Valid returns nil, which is stored as NULL.
+func (n NullPath) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil //nolint:nilnil
+	}
+	return n.Path.Value()
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// A path that is not Valid is encoded as a JSON null.
+func (n NullPath) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Path.MarshalJSON()
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// A JSON null sets Valid to false, otherwise the value is decoded using [Path.UnmarshalJSON].
+func (n *NullPath) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.Path, n.Valid = "", false
+		return nil
+	}
+	if err := n.Path.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// LogValue implements the [log/slog.LogValuer] interface.
+// A path that is not Valid is logged as an empty group.
+func (n NullPath) LogValue() slog.Value {
+	if !n.Valid {
+		return slog.GroupValue()
+	}
+	return n.Path.LogValue()
+}
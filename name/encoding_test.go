@@ -0,0 +1,170 @@
+package name_test
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/Defacto2/releaser/name"
+	"github.com/nalgeon/be"
+)
+
+var (
+	_ encoding.TextMarshaler   = name.Path("")
+	_ encoding.TextUnmarshaler = (*name.Path)(nil)
+	_ json.Marshaler           = name.Path("")
+	_ json.Unmarshaler         = (*name.Path)(nil)
+	_ sql.Scanner              = (*name.Path)(nil)
+	_ driver.Valuer            = name.Path("")
+	_ slog.LogValuer           = name.Path("")
+	_ sql.Scanner              = (*name.NullPath)(nil)
+	_ driver.Valuer            = name.NullPath{}
+	_ json.Marshaler           = name.NullPath{}
+	_ slog.LogValuer           = name.NullPath{}
+)
+
+func ExamplePath_UnmarshalJSON() {
+	var v struct {
+		Group name.Path     `json:"group"`
+		Other name.NullPath `json:"other"`
+	}
+	err := json.Unmarshal([]byte(`{"group":"The-Razor--1911","other":null}`), &v)
+	fmt.Println(string(v.Group), v.Other.Valid, err)
+	err = json.Unmarshal([]byte(`{"group":"razor#1911"}`), &v)
+	fmt.Println(err)
+	// Output: the-razor-1911 false <nil>
+	// the path contains invalid characters: invalid character '#' at offset 5
+}
+
+func TestPathText(t *testing.T) {
+	t.Parallel()
+	b, err := name.Path("razor-1911").MarshalText()
+	be.Err(t, err, nil)
+	be.Equal(t, string(b), "razor-1911")
+	_, err = name.Path("razor#1911").MarshalText()
+	be.True(t, errors.Is(err, name.ErrInvalidPath))
+	b, err = name.Path("").MarshalText()
+	be.Err(t, err, nil)
+	be.Equal(t, string(b), "")
+
+	var p name.Path
+	be.Err(t, p.UnmarshalText([]byte(" The-X-BBS ")), nil)
+	be.Equal(t, p, name.Path("x-bbs"))
+	err = p.UnmarshalText([]byte("razor#1911"))
+	be.True(t, errors.Is(err, name.ErrInvalidPath))
+	be.Equal(t, p, name.Path("x-bbs"))
+	be.Err(t, p.UnmarshalText(nil), nil)
+	be.Equal(t, p, name.Path(""))
+}
+
+func TestPathJSON(t *testing.T) {
+	t.Parallel()
+	b, err := json.Marshal(map[string]name.Path{"group": "razor-1911"})
+	be.Err(t, err, nil)
+	be.Equal(t, string(b), `{"group":"razor-1911"}`)
+	_, err = json.Marshal(name.Path("Razor 1911"))
+	be.True(t, errors.Is(err, name.ErrInvalidPath))
+
+	p := name.Path("x")
+	be.Err(t, json.Unmarshal([]byte(`null`), &p), nil)
+	be.Equal(t, p, name.Path("x"))
+	be.Err(t, json.Unmarshal([]byte(`"--razor--1911-"`), &p), nil)
+	be.Equal(t, p, name.Path("razor-1911"))
+	be.Err(t, json.Unmarshal([]byte(`1911`), &p))
+
+	// the zero value is encoded and decoded as an empty string
+	b, err = json.Marshal(struct{ P name.Path }{})
+	be.Err(t, err, nil)
+	be.Equal(t, string(b), `{"P":""}`)
+	var v struct{ P name.Path }
+	v.P = "x"
+	be.Err(t, json.Unmarshal(b, &v), nil)
+	be.Equal(t, v.P, name.Path(""))
+	b, err = json.Marshal(map[name.Path]int{"": 1})
+	be.Err(t, err, nil)
+	be.Equal(t, string(b), `{"":1}`)
+}
+
+func TestPathSQL(t *testing.T) {
+	t.Parallel()
+	var p name.Path
+	be.Err(t, p.Scan("Razor-1911"), nil)
+	be.Equal(t, p, name.Path("razor-1911"))
+	be.Err(t, p.Scan([]byte("defacto2")), nil)
+	be.Equal(t, p, name.Path("defacto2"))
+	be.True(t, errors.Is(p.Scan(nil), name.ErrScan))
+	be.True(t, errors.Is(p.Scan(1911), name.ErrScan))
+	be.True(t, errors.Is(p.Scan("razor 1911"), name.ErrInvalidPath))
+
+	v, err := name.Path("razor-1911").Value()
+	be.Err(t, err, nil)
+	be.Equal(t, v, driver.Value("razor-1911"))
+	v, err = name.Path("").Value()
+	be.Err(t, err, nil)
+	be.Equal(t, v, driver.Value(""))
+
+	// the zero value is scanned from an empty string
+	p = "x"
+	be.Err(t, p.Scan(""), nil)
+	be.Equal(t, p, name.Path(""))
+	p = "x"
+	be.Err(t, p.Scan([]byte{}), nil)
+	be.Equal(t, p, name.Path(""))
+}
+
+func TestNullPath(t *testing.T) {
+	t.Parallel()
+	var n name.NullPath
+	be.Err(t, n.Scan(nil), nil)
+	be.True(t, !n.Valid)
+	v, err := n.Value()
+	be.Err(t, err, nil)
+	be.Equal(t, v, nil)
+	b, err := json.Marshal(n)
+	be.Err(t, err, nil)
+	be.Equal(t, string(b), "null")
+
+	be.Err(t, n.Scan("razor-1911"), nil)
+	be.True(t, n.Valid)
+	v, err = n.Value()
+	be.Err(t, err, nil)
+	be.Equal(t, v, driver.Value("razor-1911"))
+	b, err = json.Marshal(n)
+	be.Err(t, err, nil)
+	be.Equal(t, string(b), `"razor-1911"`)
+	be.True(t, errors.Is(n.Scan(1911), name.ErrScan))
+
+	be.Err(t, json.Unmarshal([]byte(`null`), &n), nil)
+	be.True(t, !n.Valid)
+	be.Err(t, json.Unmarshal([]byte(`"defacto2"`), &n), nil)
+	be.True(t, n.Valid)
+	be.Equal(t, n.Path, name.Path("defacto2"))
+	be.Err(t, json.Unmarshal([]byte(`"#"`), &n))
+}
+
+func TestLogValue(t *testing.T) {
+	t.Parallel()
+	var b bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&b, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	logger.Info("releaser", "group", name.Path("acid-productions"), "none", name.NullPath{})
+	be.Equal(t, strings.TrimSpace(b.String()),
+		`level=INFO msg=releaser group.path=acid-productions group.name="ACiD Productions"`)
+	b.Reset()
+	logger.Info("releaser", "group", name.NullPath{Path: "razor-1911", Valid: true}, "bad", name.Path("#"))
+	be.Equal(t, strings.TrimSpace(b.String()),
+		`level=INFO msg=releaser group.path=razor-1911 bad.path=#`)
+}
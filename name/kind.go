@@ -0,0 +1,153 @@
+package name
+
+import (
+	"strings"
+)
+
+// A Kind is the type of releaser, such as a group, a bulletin board system or a magazine.
+type Kind uint8
+
+// The kinds of releaser.
+const (
+	Group      Kind = iota // Group is the default kind for release groups and organizations.
+	BBS                    // BBS is a bulletin board system.
+	FTP                    // FTP is a file transfer protocol site.
+	ISO                    // ISO is a group or division that releases disc images.
+	DOX                    // DOX is a group or division that releases documentation.
+	Magazine               // Magazine is a magazine, diskmag or e-zine.
+	Charts                 // Charts is a scene chart or poll.
+	Newsletter             // Newsletter is a newsletter.
+	Website                // Website is a website or network.
+)
+
+// String returns the name of the kind.
+func (k Kind) String() string {
+	switch k {
+	case Group:
+		return "group"
+	case BBS:
+		return "bbs"
+	case FTP:
+		return "ftp"
+	case ISO:
+		return "iso"
+	case DOX:
+		return "dox"
+	case Magazine:
+		return "magazine"
+	case Charts:
+		return "charts"
+	case Newsletter:
+		return "newsletter"
+	case Website:
+		return "website"
+	}
+	return ""
+}
+
+// Kinds is a map of releasers and their kind.
+type Kinds map[Path]Kind
+
+// Classified returns the curated list of releasers with a kind that
+// cannot be determined from the words in their URL path.
+func Classified() *Kinds {
+	list := Kinds{
+		"defacto2net":  Website,
+		"notwikipedia": Website,
+		"paradox":      Group,
+		"pouet":        Website,
+		"scenet":       Website,
+		"monthly-console-scene-charts-international": Charts,
+		"monthly-gameboy-scene-charts-international": Charts,
+	}
+	return &list
+}
+
+// classified is a cache of the curated kinds that is used by Classify.
+var classified = *Classified() //nolint:gochecknoglobals
+
+// Classify returns the kind of releaser for the URL path.
+// The curated [Classified] list is used first, otherwise the kind is
+// determined by the suffix of the last word in the path.
+// Domain names that end with a top-level domain are websites.
+// Cooperations are only classified when every member shares the same kind.
+//
+// Example:
+//
+//	Classify("defacto2net") = Website
+//	Classify("scene.org") = Website
+//	Classify("fairlight-dox") = DOX
+//	Classify("ice-weekly-newsletter") = Newsletter
+//	Classify("razor-1911") = Group
+//	Classify("down-town-bbs*bizare-bbs") = BBS
+func Classify(path Path) Kind {
+	p, _ := Canonical(path)
+	if kind, match := classified[p]; match {
+		return kind
+	}
+	members := strings.Split(string(p), "*")
+	kind := suffix(members[0])
+	for _, member := range members[1:] {
+		if suffix(member) != kind {
+			return Group
+		}
+	}
+	return kind
+}
+
+// suffix returns the kind of releaser based on the last word of the URL path.
+func suffix(path string) Kind {
+	if kind, match := classified[Path(path)]; match {
+		return kind
+	}
+	words := strings.FieldsFunc(path, func(r rune) bool {
+		return r == '-' || r == '_'
+	})
+	if len(words) == 0 {
+		return Group
+	}
+	last := words[len(words)-1]
+	if i := strings.LastIndex(last, "."); i > 0 && TopLevelDomain(last[i+1:]) {
+		return Website
+	}
+	switch last {
+	case "mag", "emag", "diskmag":
+		return Magazine
+	case "net":
+		return Website
+	case "charts":
+		return Charts
+	case "newsletter":
+		return Newsletter
+	}
+	switch {
+	case strings.HasSuffix(last, "bbs"):
+		return BBS
+	case strings.HasSuffix(last, "ftp"):
+		return FTP
+	case strings.HasSuffix(last, "iso"):
+		return ISO
+	case strings.HasSuffix(last, "dox"):
+		return DOX
+	case strings.HasSuffix(last, "magazine"), strings.HasSuffix(last, "zine"):
+		return Magazine
+	}
+	return Group
+}
+
+// TopLevelDomain returns true if the lowercased string is a common top-level domain,
+// such as "com", "net", "org" or a country code used by the scene.
+//
+// Example:
+//
+//	TopLevelDomain("org") = true
+//	TopLevelDomain("mail") = false
+func TopLevelDomain(s string) bool {
+	switch s {
+	case "com", "net", "org", "edu", "gov", "info", "biz", "name", "eu", "io", "tv", "cc", "nu", "ws",
+		"at", "au", "be", "ca", "ch", "cz", "de", "dk", "es", "fi", "fr", "hu", "it", "nl", "no",
+		"nz", "pl", "pt", "ru", "se", "uk", "us":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package name_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Defacto2/releaser/name"
+	"github.com/nalgeon/be"
+)
+
+func ExampleClassify() {
+	fmt.Println(name.Classify("razordox"))
+	fmt.Println(name.Classify("ice-weekly-newsletter"))
+	fmt.Println(name.Classify("defacto2net"))
+	// Output: dox
+	// newsletter
+	// website
+}
+
+func TestClassified(t *testing.T) {
+	t.Parallel()
+	// confirm all curated keys are valid
+	for key := range *name.Classified() {
+		be.True(t, key.Valid())
+	}
+}
+
+func TestClassify(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		path name.Path
+		want name.Kind
+	}{
+		{"", name.Group},
+		{"razor-1911", name.Group},
+		{"paradox", name.Group},
+		{"the-firm", name.Group},
+		{"knightmare-bbs", name.BBS},
+		{"phoenixbbs", name.BBS},
+		{"hamburger-heavan-bbs", name.BBS},
+		{"zoo-ftp", name.FTP},
+		{"risciso", name.ISO},
+		{"sos-iso", name.ISO},
+		{"razordox", name.DOX},
+		{"scd_dox", name.DOX},
+		{"fairlight-dox", name.DOX},
+		{"unreal-magazine", name.Magazine},
+		{"insomnia-emag", name.Magazine},
+		{"infinity-e_mag", name.Magazine},
+		{"htbzine", name.Magazine},
+		{"scene-charts", name.Charts},
+		{"monthly-console-scene-charts-international", name.Charts},
+		{"ice-weekly-newsletter", name.Newsletter},
+		{"extreme-net", name.Website},
+		{"defacto2net", name.Website},
+		{"scene.org", name.Website},
+		{"razor-1911.com", name.Website},
+		{"mail.bbs", name.BBS},
+		{"red-dot-bbs", name.BBS},
+		{"the-dot-org", name.Group},
+		{"pouet", name.Website},
+		{"down-town-bbs*bizare-bbs", name.BBS},
+		{"razor-1911*zoo-ftp", name.Group},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.path), func(t *testing.T) {
+			t.Parallel()
+			be.Equal(t, name.Classify(tt.path), tt.want)
+		})
+	}
+}
+
+func TestKind_String(t *testing.T) {
+	t.Parallel()
+	for kind := name.Group; kind <= name.Website; kind++ {
+		be.True(t, kind.String() != "")
+	}
+	be.Equal(t, name.Kind(255).String(), "")
+}
+
+func TestTopLevelDomain(t *testing.T) {
+	t.Parallel()
+	be.True(t, name.TopLevelDomain("org"))
+	be.True(t, name.TopLevelDomain("de"))
+	be.True(t, !name.TopLevelDomain("mail"))
+	be.True(t, !name.TopLevelDomain("ORG"))
+}
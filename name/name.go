@@ -34,15 +34,15 @@ func (path Path) String() string {
 
 // Valid returns true if the URL path uses valid characters.
 // Valid URL paths are all lowercase and contain only alphanumeric characters, dashes, underscores,
-// ampersands and asterisks.
+// ampersands and asterisks. Use [Path.Validate] to learn why a path is invalid.
 //
 // Example:
 //
 //	name.Path("acid-productions").Valid() = true
 //	name.Path("acid-productions!").Valid() = false
+//	name.Path("acid--productions").Valid() = false
 func (path Path) Valid() bool {
-	re := regexp.MustCompile(`^[a-z0-9\&\-_\*]+$`)
-	return re.MatchString(string(path))
+	return path.Validate() == nil
 }
 
 // A List is a map of releasers and their well-known styled names.
@@ -364,10 +364,11 @@ func Upper() *List {
 }
 
 // Humanize deobfuscates the URL path and returns the formatted, human-readable group name.
-// If the URL path contains invalid characters then an error is returned.
+// The dots of domain names and dotted acronyms are kept.
+// If the URL path is invalid then a [ValidationError] is returned.
 func Humanize(path Path) (string, error) {
-	if !path.Valid() {
-		return "", ErrInvalidPath
+	if err := path.Validate(); err != nil {
+		return "", err
 	}
 	s := strings.ToLower(string(path))
 	// the order of these expressions is critical
@@ -376,23 +377,71 @@ func Humanize(path Path) (string, error) {
 	s = strings.ReplaceAll(s, "-", " ")
 	s = strings.ReplaceAll(s, "_", "-")
 	s = strings.ReplaceAll(s, "*", spacedComma)
-	return s, nil
+	if !strings.Contains(s, ".") {
+		return s, nil
+	}
+	// dotted acronyms lose their trailing dot in the URL path, so it is restored
+	words := strings.Split(s, " ")
+	for i, word := range words {
+		if acronym(word) {
+			words[i] = word + "."
+		}
+	}
+	return strings.Join(words, " "), nil
+}
+
+// acronym returns true if the word is made of single letters separated by dots, such as "g.o.d".
+func acronym(word string) bool {
+	const pair = 2
+	if len(word) < pair+1 || len(word)%pair == 0 {
+		return false
+	}
+	for i := range len(word) {
+		if c := word[i]; i%pair == 1 && c != '.' || i%pair == 0 && (c < 'a' || c > 'z') {
+			return false
+		}
+	}
+	return true
+}
+
+// dots keeps the dots that are between letters or digits and removes any other dots.
+func dots(s string) string {
+	if !strings.Contains(s, ".") {
+		return s
+	}
+	alnum := func(c byte) bool {
+		return c >= 'a' && c <= 'z' || c >= '0' && c <= '9'
+	}
+	var b strings.Builder
+	for i := range len(s) {
+		if s[i] != '.' {
+			b.WriteByte(s[i])
+			continue
+		}
+		if i > 0 && i+1 < len(s) && alnum(s[i-1]) && alnum(s[i+1]) {
+			b.WriteByte('.')
+		}
+	}
+	return b.String()
 }
 
 // Obfuscate formats the named string to be used as a URL path.
+// The dots within words, such as in domain names and dotted acronyms, are kept.
 //
 // Example:
 //
 //	string(Obfuscate("ACiD Productions")) = "acid-productions"
 //	string(Obfuscate("Razor 1911 Demo & Skillion")) = "razor-1911-demo-ampersand-skillion"
 //	string(Obfuscate("TDU-Jam!")) = "tdu_jam"
+//	string(Obfuscate("Scene.org")) = "scene.org"
 func Obfuscate(name string) Path {
 	s := strings.TrimSpace(strings.ToLower(name))
-	re := regexp.MustCompile(`[^a-z0-9\&\-\,\ ]`)
+	re := regexp.MustCompile(`[^a-z0-9\&\-\,\ \.]`)
 	s = re.ReplaceAllString(s, "")
 	// the order of these expressions is critical
 	// strings.replaceall is more performant than regex
 	s = strings.ReplaceAll(s, "-", "_")
+	s = dots(s)
 	s = strings.ReplaceAll(s, spacedAmpersand, "-ampersand-")
 	s = strings.ReplaceAll(s, spacedComma, "*")
 	s = strings.ReplaceAll(s, " ", "-")
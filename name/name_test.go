@@ -44,7 +44,7 @@ func ExampleHumanize_error() {
 		fmt.Println(err)
 	}
 	// Output:
-	// the path contains invalid characters
+	// the path contains invalid characters: invalid character '#' at offset 15
 }
 
 func ExampleSpecial() {
@@ -183,6 +183,24 @@ func TestHumanize(t *testing.T) {
 			want:    "path, with, asterisk",
 			wantErr: nil,
 		},
+		{
+			name:    "path with domain",
+			path:    "scene.org-bbs",
+			want:    "scene.org bbs",
+			wantErr: nil,
+		},
+		{
+			name:    "path with dotted acronym",
+			path:    "g.o.d-network",
+			want:    "g.o.d. network",
+			wantErr: nil,
+		},
+		{
+			name:    "path with the word dot",
+			path:    "red-dot-bbs",
+			want:    "red dot bbs",
+			wantErr: nil,
+		},
 	}
 
 	for _, tt := range tests {
@@ -232,6 +250,26 @@ func TestObfuscate(t *testing.T) {
 			arg:  "John, Paul, George, Ringo",
 			want: "john*paul*george*ringo",
 		},
+		{
+			name: "domain",
+			arg:  "Scene.org BBS",
+			want: "scene.org-bbs",
+		},
+		{
+			name: "dotted acronym",
+			arg:  "G.O.D. Network",
+			want: "g.o.d-network",
+		},
+		{
+			name: "the word dot",
+			arg:  "Polka Dot Crew",
+			want: "polka-dot-crew",
+		},
+		{
+			name: "trailing dot",
+			arg:  "Group Inc.",
+			want: "group-inc",
+		},
 		{
 			name: "mixed",
 			arg:  "The quick brown fox jumps over the lazy dog, but the dog is faster",
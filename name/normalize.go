@@ -0,0 +1,155 @@
+package name
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// A Rule is the name of a URL path validation rule.
+type Rule string
+
+// The validation rules of a URL path.
+const (
+	RuleEmpty     Rule = "empty path"         // the path has no characters
+	RuleCharacter Rule = "invalid character"  // the path contains an unsupported character
+	RuleLeading   Rule = "leading separator"  // the path starts with a - _ * or . separator
+	RuleTrailing  Rule = "trailing separator" // the path ends with a - _ * or . separator
+	RuleRepeated  Rule = "repeated separator" // the path has two or more separators in a row
+)
+
+// A ValidationError describes the first rule broken by an invalid URL path.
+// It wraps [ErrInvalidPath] for use with [errors.Is].
+type ValidationError struct {
+	Path   Path // Path is the invalid URL path.
+	Rune   rune // Rune is the offending character, or utf8.RuneError for an empty path.
+	Offset int  // Offset is the byte offset of the offending character in the path.
+	Rule   Rule // Rule is the validation rule that was broken.
+}
+
+func (e *ValidationError) Error() string {
+	if e.Rule == RuleEmpty {
+		return fmt.Sprintf("%v: %s", ErrInvalidPath, e.Rule)
+	}
+	return fmt.Sprintf("%v: %s %q at offset %d", ErrInvalidPath, e.Rule, e.Rune, e.Offset)
+}
+
+func (e *ValidationError) Unwrap() error {
+	return ErrInvalidPath
+}
+
+// separator returns true if r is one of the URL path separators.
+func separator(r rune) bool {
+	return r == '-' || r == '_' || r == '*'
+}
+
+// dotted returns true if r is a URL path separator or the dot used by domain names and dotted acronyms.
+func dotted(r rune) bool {
+	return separator(r) || r == '.'
+}
+
+// character returns true if r is a character that is permitted in a URL path.
+func character(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '&' || dotted(r)
+}
+
+// Validate returns a [ValidationError] describing the first problem found
+// with the URL path, or nil if the path is valid.
+//
+// Valid URL paths are all lowercase and contain only alphanumeric characters, dashes, underscores,
+// ampersands, asterisks and dots. The dashes, underscores, asterisks and dots are separators that
+// cannot be used at the start or end of the path, or be repeated.
+//
+// Example:
+//
+//	name.Path("acid-productions").Validate() = nil
+//	name.Path("acid--productions").Validate() = "...: repeated separator '-' at offset 5"
+func (path Path) Validate() error {
+	s := string(path)
+	if s == "" {
+		return &ValidationError{Path: path, Rune: utf8.RuneError, Rule: RuleEmpty}
+	}
+	prev := rune(0)
+	for offset, r := range s {
+		switch {
+		case !character(r):
+			return &ValidationError{Path: path, Rune: r, Offset: offset, Rule: RuleCharacter}
+		case dotted(r) && offset == 0:
+			return &ValidationError{Path: path, Rune: r, Offset: offset, Rule: RuleLeading}
+		case dotted(r) && dotted(prev):
+			return &ValidationError{Path: path, Rune: r, Offset: offset, Rule: RuleRepeated}
+		case dotted(r) && offset == len(s)-1:
+			return &ValidationError{Path: path, Rune: r, Offset: offset, Rule: RuleTrailing}
+		}
+		prev = r
+	}
+	return nil
+}
+
+// Normalize fixes the correctable problems with the URL path and then validates it.
+// If the normalized path is still invalid, it is returned with a [ValidationError].
+//
+//   - The path is lowercased and surrounding whitespace is removed
+//   - Repeated separators are merged, an asterisk outranks an underscore which outranks a dash
+//   - Leading and trailing separators, including any dangling -ampersand-, are removed
+//   - If found "the-" prefix from BBS and FTP named sites is removed
+//
+// Example:
+//
+//	Normalize("The-X-BBS") = "x-bbs", nil
+//	Normalize("--razor--1911-") = "razor-1911", nil
+//	Normalize("-ampersand-razor-1911*-trsi") = "razor-1911*trsi", nil
+//	Normalize("razor#1911") = "razor#1911", &ValidationError{Rune: '#', Offset: 5, Rule: RuleCharacter}
+func Normalize(path Path) (Path, error) {
+	s := strings.ToLower(strings.TrimSpace(string(path)))
+	s = mergeSeparators(s)
+	members := strings.Split(s, "*")
+	fixes := make([]string, 0, len(members))
+	for _, member := range members {
+		member = strings.TrimPrefix(member, "-ampersand-")
+		member = strings.TrimSuffix(member, "-ampersand-")
+		member = strings.Trim(member, "-_.")
+		if member == "" {
+			continue
+		}
+		if strings.HasPrefix(member, "the-") &&
+			(strings.HasSuffix(member, "-bbs") || strings.HasSuffix(member, "-ftp")) {
+			member = strings.TrimPrefix(member, "the-")
+		}
+		fixes = append(fixes, member)
+	}
+	p := Path(strings.Join(fixes, "*"))
+	if err := p.Validate(); err != nil {
+		return p, err
+	}
+	return p, nil
+}
+
+// mergeSeparators replaces each run of separators with its highest ranked separator.
+func mergeSeparators(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	run := rune(0)
+	for _, r := range s {
+		if separator(r) {
+			switch {
+			case r == '*', run == '*':
+				run = '*'
+			case r == '_', run == '_':
+				run = '_'
+			default:
+				run = '-'
+			}
+			continue
+		}
+		if run != 0 {
+			b.WriteRune(run)
+			run = 0
+		}
+		b.WriteRune(r)
+	}
+	if run != 0 {
+		b.WriteRune(run)
+	}
+	return b.String()
+}
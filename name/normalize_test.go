@@ -0,0 +1,113 @@
+package name_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Defacto2/releaser/name"
+	"github.com/nalgeon/be"
+)
+
+func ExampleNormalize() {
+	p, err := name.Normalize("The--Knightmare-BBS-")
+	fmt.Println(string(p), err)
+
+	_, err = name.Normalize("razor#1911")
+	var verr *name.ValidationError
+	if errors.As(err, &verr) {
+		fmt.Printf("%q %d %s\n", verr.Rune, verr.Offset, verr.Rule)
+	}
+	// Output: knightmare-bbs <nil>
+	// '#' 5 invalid character
+}
+
+func ExamplePath_Validate() {
+	fmt.Println(name.Path("acid-productions").Validate())
+	fmt.Println(name.Path("acid--productions").Validate())
+	// Output: <nil>
+	// the path contains invalid characters: repeated separator '-' at offset 5
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		path   name.Path
+		rule   name.Rule
+		r      rune
+		offset int
+	}{
+		{"valid", "razor-1911-demo*trsi", "", 0, 0},
+		{"valid ampersand", "razor-1911-demo-ampersand-skillion", "", 0, 0},
+		{"valid domain", "scene.org-bbs", "", 0, 0},
+		{"empty", "", name.RuleEmpty, 0, 0},
+		{"uppercase", "Razor", name.RuleCharacter, 'R', 0},
+		{"symbol", "razor-1911-demo#trsi", name.RuleCharacter, '#', 15},
+		{"multibyte", "pouët-bbs", name.RuleCharacter, 'ë', 3},
+		{"leading", "--x", name.RuleLeading, '-', 0},
+		{"leading ampersand", "-ampersand-x", name.RuleLeading, '-', 0},
+		{"repeated", "a**b", name.RuleRepeated, '*', 2},
+		{"repeated mixed", "a-_b", name.RuleRepeated, '_', 2},
+		{"trailing", "razor-", name.RuleTrailing, '-', 5},
+		{"trailing ampersand", "razor-ampersand-", name.RuleTrailing, '-', 15},
+		{"leading dot", ".org", name.RuleLeading, '.', 0},
+		{"repeated dot", "scene-.org", name.RuleRepeated, '.', 6},
+		{"trailing dot", "g.o.d.", name.RuleTrailing, '.', 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.path.Validate()
+			if tt.rule == "" {
+				be.Err(t, err, nil)
+				be.True(t, tt.path.Valid())
+				return
+			}
+			be.True(t, !tt.path.Valid())
+			be.Err(t, err, name.ErrInvalidPath)
+			var verr *name.ValidationError
+			be.True(t, errors.As(err, &verr))
+			be.Equal(t, verr.Rule, tt.rule)
+			if tt.rule != name.RuleEmpty {
+				be.Equal(t, verr.Rune, tt.r)
+				be.Equal(t, verr.Offset, tt.offset)
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		path    name.Path
+		want    name.Path
+		wantErr error
+	}{
+		{"valid", "razor-1911", "razor-1911", nil},
+		{"case", "Razor-1911", "razor-1911", nil},
+		{"whitespace", " razor-1911 ", "razor-1911", nil},
+		{"repeated", "razor--1911", "razor-1911", nil},
+		{"repeated coop", "razor-1911**trsi", "razor-1911*trsi", nil},
+		{"ranked", "razor-*-trsi", "razor*trsi", nil},
+		{"leading", "--razor", "razor", nil},
+		{"trailing", "razor_-", "razor", nil},
+		{"ampersand ends", "-ampersand-razor-ampersand-", "razor", nil},
+		{"ampersand", "razor-ampersand-trsi", "razor-ampersand-trsi", nil},
+		{"the bbs", "the-x-bbs", "x-bbs", nil},
+		{"the ftp", "The-X-FTP", "x-ftp", nil},
+		{"the group", "the-firm", "the-firm", nil},
+		{"the coop", "the-x-bbs*the-y-ftp", "x-bbs*y-ftp", nil},
+		{"empty", "---", "", name.ErrInvalidPath},
+		{"invalid", "razor#1911", "razor#1911", name.ErrInvalidPath},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := name.Normalize(tt.path)
+			be.Err(t, err, tt.wantErr)
+			be.Equal(t, got, tt.want)
+		})
+	}
+}
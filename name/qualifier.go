@@ -0,0 +1,138 @@
+package name
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Qualifiers are appended to the names of releasers that share the same name,
+// such as "iMAGE (NJ)" or "iMAGE Productions (#2)". In a URL path the qualifier is
+// the last word, such as "image-nj" or "image-productions-2".
+//
+// A qualifier is one of the following:
+//   - A region, such as a country or an US state code, "(NJ)" or "(UK)"
+//   - A platform, such as "(Amiga)" or "(C64)"
+//   - A number of one or two digits, "(#2)"
+//   - A year between 1970 and 2029, "(1994)"
+
+// regions are the region codes that are used as qualifiers, keyed by their URL path word.
+// Codes that are also common English words, such as "in", "me" or "or", are not included.
+func regions() map[string]string {
+	return map[string]string{
+		"ak": "AK", "az": "AZ", "ca": "CA", "ct": "CT", "dc": "DC", "fl": "FL", "ga": "GA",
+		"ia": "IA", "il": "IL", "ks": "KS", "ky": "KY", "md": "MD", "mi": "MI", "mn": "MN",
+		"mo": "MO", "ms": "MS", "mt": "MT", "nc": "NC", "nd": "ND", "ne": "NE", "nh": "NH",
+		"nj": "NJ", "nm": "NM", "nv": "NV", "ny": "NY", "ri": "RI", "sc": "SC", "sd": "SD",
+		"tn": "TN", "tx": "TX", "ut": "UT", "va": "VA", "vt": "VT", "wa": "WA", "wi": "WI",
+		"wv": "WV", "wy": "WY",
+		"au": "AU", "br": "BR", "ch": "CH", "cz": "CZ", "dk": "DK", "fi": "FI", "fr": "FR",
+		"hu": "HU", "nl": "NL", "nz": "NZ", "pl": "PL", "ru": "RU", "se": "SE", "uk": "UK",
+		"usa": "USA",
+	}
+}
+
+// platforms are the computer and console platforms that are used as qualifiers, keyed by their URL path word.
+func platforms() map[string]string {
+	return map[string]string{
+		"amiga": "Amiga", "atari": "Atari", "c64": "C64", "dos": "DOS", "gba": "GBA",
+		"linux": "Linux", "mac": "Mac", "nes": "NES", "pc": "PC", "psx": "PSX",
+		"snes": "SNES", "windows": "Windows",
+	}
+}
+
+// qualifier returns the styled qualifier for the last word of a URL path,
+// or an empty string if the word is not a qualifier.
+func qualifier(word string) string {
+	if s, found := regions()[word]; found {
+		return s
+	}
+	if s, found := platforms()[word]; found {
+		return s
+	}
+	n, err := strconv.Atoi(word)
+	if err != nil || strconv.Itoa(n) != word {
+		return ""
+	}
+	const maxNumber, firstYear, lastYear = 99, 1970, 2029
+	switch {
+	case n > 0 && n <= maxNumber:
+		return "#" + word
+	case n >= firstYear && n <= lastYear:
+		return word
+	}
+	return ""
+}
+
+// Parts splits the URL path into the base path and the styled qualifier that is
+// used to tell apart releasers that share the same name.
+// If the path has no qualifier then the path is returned with an empty string.
+// Cooperations and paths of a single word have no qualifier.
+//
+// Parts only checks the syntax of the path, and does not check whether
+// the base path belongs to a known releaser.
+//
+// Example:
+//
+//	Path("image-nj").Parts() = "image", "NJ"
+//	Path("image-productions-2").Parts() = "image-productions", "#2"
+//	Path("razor-1911-amiga").Parts() = "razor-1911", "Amiga"
+//	Path("razor-1911").Parts() = "razor-1911", ""
+func (path Path) Parts() (Path, string) {
+	p := strings.ToLower(string(path))
+	if strings.Contains(p, "*") {
+		return path, ""
+	}
+	i := strings.LastIndex(p, "-")
+	if i < 1 || strings.HasSuffix(p[:i], "-ampersand") {
+		return path, ""
+	}
+	q := qualifier(p[i+1:])
+	if q == "" {
+		return path, ""
+	}
+	return Path(p[:i]), q
+}
+
+// Qualifier returns the styled qualifier of the URL path,
+// or an empty string if the path has no qualifier.
+//
+// Example:
+//
+//	Path("image-nj").Qualifier() = "NJ"
+//	Path("image-productions-2").Qualifier() = "#2"
+func (path Path) Qualifier() string {
+	_, q := path.Parts()
+	return q
+}
+
+// Qualify returns the URL path word for the styled qualifier,
+// or false if the string is not a qualifier.
+// The string may be wrapped in parentheses.
+//
+// Example:
+//
+//	Qualify("(NJ)") = "nj", true
+//	Qualify("#2") = "2", true
+//	Qualify("Amiga") = "amiga", true
+//	Qualify("(The Best)") = "", false
+func Qualify(s string) (string, bool) {
+	s = strings.TrimSpace(s)
+	s = strings.TrimSuffix(strings.TrimPrefix(s, "("), ")")
+	word := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(s), "#"))
+	if qualifier(word) == "" {
+		return "", false
+	}
+	return word, true
+}
+
+// StyledQualifier returns the styled qualifier for the URL path word,
+// or an empty string if the word is not a qualifier. It is the reverse of [Qualify].
+//
+// Example:
+//
+//	StyledQualifier("nj") = "NJ"
+//	StyledQualifier("2") = "#2"
+//	StyledQualifier("amiga") = "Amiga"
+func StyledQualifier(word string) string {
+	return qualifier(strings.ToLower(word))
+}
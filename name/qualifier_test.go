@@ -0,0 +1,96 @@
+package name_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Defacto2/releaser/name"
+	"github.com/nalgeon/be"
+)
+
+func ExamplePath_Parts() {
+	base, qualifier := name.Path("image-productions-2").Parts()
+	fmt.Println(string(base), qualifier)
+	// Output: image-productions #2
+}
+
+func ExampleQualify() {
+	word, ok := name.Qualify("(NJ)")
+	fmt.Println(word, ok)
+	// Output: nj true
+}
+
+func TestParts(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		path      name.Path
+		base      name.Path
+		qualifier string
+	}{
+		{"", "", ""},
+		{"image-nj", "image", "NJ"},
+		{"image-productions-2", "image-productions", "#2"},
+		{"razor-1911-amiga", "razor-1911", "Amiga"},
+		{"razor-1911-c64", "razor-1911", "C64"},
+		{"fairlight-1994", "fairlight", "1994"},
+		{"Image-NJ", "image", "NJ"},
+		{"razor-1911", "razor-1911", ""},
+		{"amiga", "amiga", ""},
+		{"the-way-in", "the-way-in", ""},
+		{"team-0", "team-0", ""},
+		{"team-02", "team-02", ""},
+		{"team-100", "team-100", ""},
+		{"razor-1911-demo-ampersand-2", "razor-1911-demo-ampersand-2", ""},
+		{"class*razor-1911-amiga", "class*razor-1911-amiga", ""},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.path), func(t *testing.T) {
+			t.Parallel()
+			base, qualifier := tt.path.Parts()
+			be.Equal(t, base, tt.base)
+			be.Equal(t, qualifier, tt.qualifier)
+			be.Equal(t, tt.path.Qualifier(), tt.qualifier)
+		})
+	}
+}
+
+func TestQualify(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		s    string
+		want string
+		ok   bool
+	}{
+		{"", "", false},
+		{"(NJ)", "nj", true},
+		{"(#2)", "2", true},
+		{"#12", "12", true},
+		{"( Amiga )", "amiga", true},
+		{"(1994)", "1994", true},
+		{"(The Best)", "", false},
+		{"(#0)", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+			word, ok := name.Qualify(tt.s)
+			be.Equal(t, word, tt.want)
+			be.Equal(t, ok, tt.ok)
+		})
+	}
+}
+
+func TestStyledQualifier(t *testing.T) {
+	t.Parallel()
+	be.Equal(t, name.StyledQualifier("nj"), "NJ")
+	be.Equal(t, name.StyledQualifier("2"), "#2")
+	be.Equal(t, name.StyledQualifier("Amiga"), "Amiga")
+	be.Equal(t, name.StyledQualifier("1994"), "1994")
+	be.Equal(t, name.StyledQualifier("best"), "")
+	for _, s := range []string{"(NJ)", "#12", "(Amiga)", "(1994)"} {
+		word, ok := name.Qualify(s)
+		be.True(t, ok)
+		be.Equal(t, name.StyledQualifier(word), strings.Trim(s, "()"))
+	}
+}
@@ -0,0 +1,215 @@
+// Package release provides a parser for the directory names of scene releases.
+//
+// Scene releases are commonly named using words separated by dots, underscores or spaces,
+// followed by a dash and the tag of the release group.
+// For example, Some.Game.v1.2.Incl.Keygen-RZR or App_Name_v3_Cracked-TDT.
+package release
+
+import (
+	"errors"
+	"maps"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/Defacto2/releaser"
+	"github.com/Defacto2/releaser/initialism"
+	"github.com/Defacto2/releaser/name"
+)
+
+var (
+	// ErrNoGroup is returned when the release name does not end with a group tag.
+	ErrNoGroup = errors.New("the release name has no group tag")
+	// ErrUnknownTag is returned when the group tag is not a known releaser.
+	ErrUnknownTag = errors.New("the group tag is unknown")
+)
+
+// A Release is the parsed directory name of a scene release.
+type Release struct {
+	Title   string      // Title is the release title with the separators replaced by spaces.
+	Version string      // Version is the version number of the release, e.g. "v1.2".
+	Tags    []string    // Tags are the known release tags, e.g. "Incl.Keygen" or "PROPER".
+	Extra   []string    // Extra are the unrecognized words that follow the title.
+	Group   string      // Group is the group tag as it is written, e.g. "RZR".
+	Paths   []name.Path // Paths are the candidate URL paths of the releasers for the group tag.
+}
+
+// Unknown returns true if the release has a group tag that could not be resolved.
+func (r Release) Unknown() bool {
+	return r.Group != "" && len(r.Paths) == 0
+}
+
+// tags are the known release tags, the keys are lowercased and use a dot separator.
+func tags() map[string]string {
+	return map[string]string{
+		"incl.keygen":  "Incl.Keygen",
+		"incl.crack":   "Incl.Crack",
+		"incl.patch":   "Incl.Patch",
+		"incl.trainer": "Incl.Trainer",
+		"incl.serial":  "Incl.Serial",
+		"cracked":      "Cracked",
+		"keygen":       "Keygen",
+		"proper":       "PROPER",
+		"repack":       "REPACK",
+		"internal":     "iNTERNAL",
+		"dirfix":       "DIRFIX",
+		"nfofix":       "NFOFIX",
+		"read.nfo":     "READ.NFO",
+		"dox":          "DOX",
+		"iso":          "ISO",
+	}
+}
+
+// index is a cache of the lowercased initialisms and URL paths that is used by Resolve.
+var index = newIndex() //nolint:gochecknoglobals
+
+// newIndex returns the URL paths of the releasers keyed by their lowercased
+// URL paths, initialisms, acronyms and alternative spellings.
+func newIndex() map[string][]name.Path {
+	idx := make(map[string][]name.Path)
+	add := func(key string, path name.Path) {
+		key = strings.ToLower(key)
+		if !slices.Contains(idx[key], path) {
+			idx[key] = append(idx[key], path)
+		}
+	}
+	for path := range maps.Keys(*name.Special()) {
+		add(string(path), path)
+	}
+	for path, values := range maps.All(*initialism.Initialisms()) {
+		add(string(path), name.Path(path))
+		for value := range slices.Values(values) {
+			add(value, name.Path(path))
+		}
+	}
+	for key := range idx {
+		slices.Sort(idx[key])
+	}
+	return idx
+}
+
+// Resolve returns the candidate URL paths of the releasers for the group tag.
+// The tag is matched case-insensitively against the initialisms and the URL paths,
+// and then against the known URL paths of names that join their words together, such as "Razor1911".
+// If nothing matches then [ErrUnknownTag] is returned.
+//
+// Example:
+//
+//	Resolve("TDT") = []name.Path{"the-dream-team"}, nil
+//	Resolve("RZR") = []name.Path{"razor-1911", "razor-1911-demo", "razordox"}, nil
+//	Resolve("Razor1911") = []name.Path{"razor-1911"}, nil
+func Resolve(tag string) ([]name.Path, error) {
+	tag = strings.TrimSpace(tag)
+	paths := index[strings.ToLower(tag)]
+	if len(paths) == 0 {
+		if path, found := releaser.Unjoin(tag); found {
+			return []name.Path{path}, nil
+		}
+		return nil, ErrUnknownTag
+	}
+	return slices.Clone(paths), nil
+}
+
+var (
+	versionRe = regexp.MustCompile(`^[vV]\d+[a-zA-Z]?$`)
+	numberRe  = regexp.MustCompile(`^\d+[a-zA-Z]?$`)
+	groupRe   = regexp.MustCompile(`^[A-Za-z0-9]+$`)
+)
+
+// Parse parses the directory name of a scene release.
+// The group tag is the word following the last dash, and is resolved to the
+// candidate URL paths of the releasers using [Resolve].
+// Any leading directories using either forward or back slashes are ignored.
+//
+// If the name has no group tag then [ErrNoGroup] is returned.
+// If the group tag cannot be resolved then the parsed release is returned
+// with [ErrUnknownTag] and [Release.Unknown] reports true.
+//
+// Example:
+//
+//	Parse("Some.Game.v1.2.Incl.Keygen-RZR") = Release{
+//		Title: "Some Game", Version: "v1.2", Tags: []string{"Incl.Keygen"},
+//		Group: "RZR", Paths: []name.Path{"razor-1911", "razor-1911-demo", "razordox"},
+//	}
+//	Parse("App_Name_v3_Cracked-TDT") = Release{
+//		Title: "App Name", Version: "v3", Tags: []string{"Cracked"},
+//		Group: "TDT", Paths: []name.Path{"the-dream-team"},
+//	}
+func Parse(s string) (Release, error) {
+	var r Release
+	s = strings.TrimSpace(path.Base(strings.ReplaceAll(s, `\`, "/")))
+	if s == "." || s == "/" {
+		s = ""
+	}
+	body, group, found := cut(s)
+	if !found {
+		s = strings.TrimRight(s, "-._ ")
+		r.Title, r.Version, r.Tags, r.Extra = words(s)
+		return r, ErrNoGroup
+	}
+	r.Title, r.Version, r.Tags, r.Extra = words(body)
+	r.Group = group
+	paths, err := Resolve(group)
+	if err != nil {
+		return r, err
+	}
+	r.Paths = paths
+	return r, nil
+}
+
+// cut splits the release name at the last dash into the body and the group tag.
+func cut(s string) (string, string, bool) {
+	i := strings.LastIndex(s, "-")
+	if i < 1 {
+		return "", "", false
+	}
+	body, group := s[:i], s[i+1:]
+	if !groupRe.MatchString(group) {
+		return "", "", false
+	}
+	return body, group, true
+}
+
+// words splits the body of a release name into the title, version, tags and extra words.
+func words(body string) (string, string, []string, []string) {
+	fields := strings.FieldsFunc(body, func(r rune) bool {
+		return r == '.' || r == '_' || r == ' '
+	})
+	known := tags()
+	var title, tagged, extra []string
+	version := ""
+	ended := false
+	for i := 0; i < len(fields); i++ {
+		field := fields[i]
+		if i+1 < len(fields) {
+			pair := strings.ToLower(field + "." + fields[i+1])
+			if tag, match := known[pair]; match {
+				tagged = append(tagged, tag)
+				ended = true
+				i++
+				continue
+			}
+		}
+		if tag, match := known[strings.ToLower(field)]; match && (ended || len(title) > 0) {
+			tagged = append(tagged, tag)
+			ended = true
+			continue
+		}
+		if version == "" && versionRe.MatchString(field) && len(title) > 0 {
+			version = strings.ToLower(field[:1]) + field[1:]
+			for i+1 < len(fields) && numberRe.MatchString(fields[i+1]) {
+				version += "." + fields[i+1]
+				i++
+			}
+			ended = true
+			continue
+		}
+		if ended {
+			extra = append(extra, field)
+			continue
+		}
+		title = append(title, field)
+	}
+	return strings.Join(title, " "), version, tagged, extra
+}
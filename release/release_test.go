@@ -0,0 +1,130 @@
+package release_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Defacto2/releaser/name"
+	"github.com/Defacto2/releaser/release"
+	"github.com/nalgeon/be"
+)
+
+func ExampleParse() {
+	r, err := release.Parse("Some.Game.v1.2.Incl.Keygen-RZR")
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(r.Title)
+	fmt.Println(r.Version)
+	fmt.Println(r.Tags)
+	fmt.Println(r.Group, len(r.Paths))
+	// Output: Some Game
+	// v1.2
+	// [Incl.Keygen]
+	// RZR 3
+}
+
+func ExampleParse_unknown() {
+	r, err := release.Parse("App_Name_v3_Cracked-QWZXV")
+	fmt.Println(err)
+	fmt.Println(r.Unknown())
+	// Output: the group tag is unknown
+	// true
+}
+
+func ExampleResolve() {
+	paths, _ := release.Resolve("TDT")
+	for _, path := range paths {
+		fmt.Println(string(path))
+	}
+	// Output: the-dream-team
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		s       string
+		want    release.Release
+		wantErr error
+	}{
+		{
+			"dotted", "Some.Game.v1.2.Incl.Keygen-RZR",
+			release.Release{
+				Title: "Some Game", Version: "v1.2", Tags: []string{"Incl.Keygen"}, Group: "RZR",
+				Paths: []name.Path{"razor-1911", "razor-1911-demo", "razordox"},
+			}, nil,
+		},
+		{
+			"underscored", "App_Name_v3_Cracked-TDT",
+			release.Release{
+				Title: "App Name", Version: "v3", Tags: []string{"Cracked"}, Group: "TDT",
+				Paths: []name.Path{"the-dream-team"},
+			}, nil,
+		},
+		{
+			"directory", "/mnt/incoming/Half-Life.PROPER.REPACK-TRSi",
+			release.Release{
+				Title: "Half-Life", Tags: []string{"PROPER", "REPACK"}, Group: "TRSi",
+				Paths: []name.Path{"tristar-ampersand-red-sector-inc", "trsi"},
+			}, nil,
+		},
+		{
+			"windows directory", `C:\incoming\App_Name_v3_Cracked-TDT`,
+			release.Release{
+				Title: "App Name", Version: "v3", Tags: []string{"Cracked"}, Group: "TDT",
+				Paths: []name.Path{"the-dream-team"},
+			}, nil,
+		},
+		{
+			"extra", "Some.Game.V2.German.DOX-RZR",
+			release.Release{
+				Title: "Some Game", Version: "v2", Tags: []string{"DOX"}, Extra: []string{"German"},
+				Group: "RZR", Paths: []name.Path{"razor-1911", "razor-1911-demo", "razordox"},
+			}, nil,
+		},
+		{
+			"unknown", "Some.Game.ISO-QWZXV",
+			release.Release{Title: "Some Game", Tags: []string{"ISO"}, Group: "QWZXV"},
+			release.ErrUnknownTag,
+		},
+		{
+			"no group", "Some.Game.v1",
+			release.Release{Title: "Some Game", Version: "v1"},
+			release.ErrNoGroup,
+		},
+		{
+			"no group name", "Some.Game.v1-",
+			release.Release{Title: "Some Game", Version: "v1"},
+			release.ErrNoGroup,
+		},
+		{"empty", "", release.Release{}, release.ErrNoGroup},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := release.Parse(tt.s)
+			be.Err(t, err, tt.wantErr)
+			be.Equal(t, got, tt.want)
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+	paths, err := release.Resolve("rzr")
+	be.Err(t, err, nil)
+	be.Equal(t, paths, []name.Path{"razor-1911", "razor-1911-demo", "razordox"})
+
+	paths, err = release.Resolve("fairlight")
+	be.Err(t, err, nil)
+	be.True(t, len(paths) > 0)
+
+	paths, err = release.Resolve("Razor1911")
+	be.Err(t, err, nil)
+	be.Equal(t, paths, []name.Path{"razor-1911"})
+
+	paths, err = release.Resolve("")
+	be.Err(t, err, release.ErrUnknownTag)
+	be.Equal(t, len(paths), 0)
+}
@@ -18,24 +18,59 @@ var initialisms = initialism.Initialisms() //nolint:gochecknoglobals
 // specials are a cache of that greatly improves benchmark performance.
 var specials = name.Special() //nolint:gochecknoglobals
 
+// knowns are a cache of the lowercased special names and initialisms that is used by known.
+var knowns = func() map[string]bool { //nolint:gochecknoglobals
+	m := make(map[string]bool, len(*specials)+len(*initialisms))
+	for _, special := range *specials {
+		m[strings.ToLower(special)] = true
+	}
+	for _, values := range *initialisms {
+		for _, value := range values {
+			m[strings.ToLower(value)] = true
+		}
+	}
+	return m
+}()
+
+// known returns true if the string is a known special name, initialism, acronym or alternative spelling.
+func known(s string) bool {
+	return knowns[strings.ToLower(s)]
+}
+
 // Cell formats the string to be used as a cell in a database table.
 //
+//   - The removal of decorations from both ends, unless part of a known name
+//   - The joining of spaced out or dotted letters, such as "R A Z O R" or "S.W.A.T"
 //   - The removal of duplicate spaces
 //   - The removal of excess whitespace
 //   - If found "The " prefix from BBS and FTP named sites
-//   - The stripping of incompatible characters
+//   - The stripping of incompatible characters and apostrophes
 //
 // Compatible characters include: A-Z a-z À-Ö Ø-ö ø-ÿ 0-9 - , &
+// Dots are only kept within dotted acronyms and domain names, such as "G.O.D." or "scene.org".
+//
+// Earlier versions removed every dot, so cells stored by them, such as "DEFACTO2NET"
+// for "defacto2.net", differ from the current "DEFACTO2.NET". The dots cannot be restored
+// from the stored cell, so those rows must be recreated from their original names,
+// or be updated with a reviewed statement such as
+// UPDATE files SET group_brand_for = 'DEFACTO2.NET' WHERE group_brand_for = 'DEFACTO2NET'.
+// [FromCell] still finds the known releasers of the cells stored without dots.
 //
 // Example:
 //
 //	Cell("  Defacto2  demo  group.") = "DEFACTO2 DEMO GROUP"
 //	Cell("the x bbs") = "X BBS"
-//	Cell("defacto2.net") = "DEFACTO2NET"
+//	Cell("defacto2.net") = "DEFACTO2.NET"
+//	Cell("(defacto2.net)") = "DEFACTO2.NET"
 //	Cell("TDT / TRSi") = "TDT TRSI"
 //	Cell("TDT,TRSi") = "TDT, TRSI"
+//	Cell("-=xX Razor 1911 Xx=-") = "RAZOR 1911"
+//	Cell("R A Z O R  1 9 1 1") = "RAZOR 1911"
+//	Cell("The Devil's Realm BBS") = "DEVILS REALM BBS"
 func Cell(s string) string {
-	x := fix.StripChars(s)
+	x := fix.StripEndsKnown(s, known)
+	x = fix.Collapse(x)
+	x = fix.StripChars(x)
 	x = fix.StripStart(x)
 	x = strings.TrimSpace(x)
 	x = fix.TrimThe(x)
@@ -47,12 +82,16 @@ func Cell(s string) string {
 // It does not apply any name deobfuscations such as initials or abbreviations,
 // as it only stylizes the string.
 //
+//   - The removal of decorations from both ends, unless part of a known name
+//   - The joining of spaced out or dotted letters, such as "R A Z O R" or "S.W.A.T"
 //   - The removal of duplicate spaces
 //   - The removal of excess whitespace
 //   - If found "The " prefix from BBS and FTP named sites
 //   - The stripping of incompatible characters
+//   - The restoration of apostrophes in known contractions and possessives
 //
-// Compatible characters include: A-Z a-z À-Ö Ø-ö ø-ÿ 0-9 - , &
+// Compatible characters include: A-Z a-z À-Ö Ø-ö ø-ÿ 0-9 - , & '
+// Dots are only kept within dotted acronyms and domain names, such as "G.O.D." or "scene.org".
 //
 // Example:
 //
@@ -61,8 +100,19 @@ func Cell(s string) string {
 //	Clean("The X Ftp") = "X FTP"
 //	Clean("tdt / trsi") = "Tdt Trsi" // behaves as a single group
 //	Clean("tdt,trsi") = "Tdt, TRSi"  // behaves as two groups
+//	Clean(".oO Fairlight Oo.") = "Fairlight"
+//	Clean("F.A.I.R.L.I.G.H.T") = "Fairlight"
+//	Clean("the devils realm bbs") = "Devil's Realm BBS"
+//	Clean("SCENE.ORG") = "Scene.org"
 func Clean(s string) string {
-	x := fix.StripChars(s)
+	if base, word := qualified(strings.TrimSpace(s)); word != "" {
+		if x := Clean(base); x != "" {
+			return x + " (" + name.StyledQualifier(word) + ")"
+		}
+	}
+	x := fix.StripEndsKnown(s, known)
+	x = fix.Collapse(x)
+	x = fix.StripChars(x)
 	x = fix.StripStart(x)
 	x = strings.TrimSpace(x)
 	x = fix.TrimThe(x)
@@ -73,6 +123,12 @@ func Clean(s string) string {
 // Humanize deobfuscates the URL path and returns the formatted, human-readable group name.
 // The path is expected to be in the format of a URL path without the scheme or domain.
 // If the URL path contains invalid characters then an empty string is returned.
+// Obsolete URL paths listed in [name.Aliases] are humanized using their canonical path,
+// and joined-up paths of known releasers are humanized using their known path.
+// Paths of a known releaser with a qualifier, such as a region, platform or number,
+// are humanized with the qualifier in parentheses, see [name.Path.Parts].
+// Unknown names are never split, so "team-17" remains "Team 17",
+// but a region qualifier is uppercased, so "unknown-group-nj" is "Unknown Group NJ".
 //
 // Example:
 //
@@ -81,31 +137,83 @@ func Clean(s string) string {
 //	Humanize("razor-1911-demo-ampersand-skillion") = "Razor 1911 Demo & Skillion"
 //	Humanize("north-american-pirate_phreak-association") = "North American Pirate-Phreak Association"
 //	Humanize("coop") = "TDT / TRSi"
+//	Humanize("thedreamteam") = "The Dream Team"
+//	Humanize("devils-realm-bbs") = "Devil's Realm BBS"
+//	Humanize("razor-1911-amiga") = "Razor 1911 (Amiga)"
+//	Humanize("the-dream-team-2") = "The Dream Team (#2)"
+//	Humanize("unknown-group-nj") = "Unknown Group NJ" // unknown base
+//	Humanize("scene.org") = "Scene.org"
+//	Humanize("red-dot-bbs") = "Red Dot BBS"
 //	Humanize("united-software-association*fairlight") =
 //		"United Software Association + Fairlight PC Division" // special name
 //	Humanize("razor-1911-demo*trsi") = "Razor 1911 Demo, TRSi"
 //	Humanize("razor-1911-demo#trsi") = "" // invalid # character
 func Humanize(path string) string {
-	p := name.Path(strings.ToLower(path))
+	p := resolve(path)
 	if special := p.String(); special != "" {
 		return special
 	}
+	if base, qualifier := p.Parts(); qualifier != "" && !paths[p] {
+		if paths[resolve(string(base))] {
+			return Humanize(string(base)) + " (" + qualifier + ")"
+		}
+		if h := Humanize(string(base)); h != "" {
+			return h + " " + strings.TrimPrefix(qualifier, "#")
+		}
+	}
 	s, err := name.Humanize(p)
 	if err != nil {
 		return ""
 	}
-	return Clean(s)
+	x := Clean(s)
+	if strings.Contains(x, "'") && curated(p, strings.ReplaceAll(x, "'", "")) {
+		return strings.ReplaceAll(x, "'", "")
+	}
+	return x
+}
+
+// curated returns true if a listed spelling of the known URL path, without any apostrophes,
+// matches the name, ignoring the case and a leading "The". The curated spelling is
+// preferred over the apostrophes restored by [fix.Apostrophe].
+func curated(path name.Path, s string) bool {
+	const the = "the "
+	trim := func(x string) string {
+		x = strings.ToLower(x)
+		return strings.TrimPrefix(x, the)
+	}
+	for _, value := range (*initialisms)[initialism.Path(path)] {
+		if !strings.Contains(value, "'") && trim(value) == trim(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolve returns the URL path that is used to humanize and index the path.
+// Obsolete URL paths are replaced by their canonical path, and joined-up paths
+// of known releasers are replaced by their known path.
+func resolve(path string) name.Path {
+	p, _ := name.Canonical(name.Path(path))
+	if !paths[p] && strings.IndexFunc(string(p), separator) < 0 {
+		if joined, found := Unjoin(string(p)); found {
+			p = joined
+		}
+	}
+	return p
 }
 
 // Index deobfuscates the URL path and applies [releaser.Humanize] so that it can
 // be stored in a database table as a releaser key and index in the database table.
+// The members of a cooperation are kept in their listed order, see [IndexKey].
+// If the URL path contains invalid characters then an empty string is returned.
+//
+// Example:
+//
+//	Index("razor-1911-demo") = "RAZOR 1911 DEMO"
+//	Index("coop") = "TDT / TRSI"
+//	Index("class*paradigm*razor-1911") = "CLASS, PARADIGM, RAZOR 1911"
 func Index(path string) string {
-	p := name.Path(strings.ToLower(path))
-	s, err := name.Humanize(p)
-	if err != nil {
-		return ""
-	}
-	return strings.ToUpper(s)
+	return IndexKey(path, OrderListed)
 }
 
 // Link deobfuscates the URL path and applies [releaser.Humanize].
@@ -133,6 +241,10 @@ func Link(path string) string {
 //	Obfuscate("Razor 1911 Demo & Skillion") = "razor-1911-demo-ampersand-skillion"
 //	Obfuscate("TDU-Jam!") = "tdu_jam"
 //	Obfuscate("The 12AM BBS.") = "12am-bbs"
+//	Obfuscate("R A Z O R  1 9 1 1") = "razor-1911"
+//	Obfuscate("The Dream Team (#2)") = "the-dream-team-2"
+//	Obfuscate("TDT (#2)") = "the-dream-team-2"
+//	Obfuscate("Scene.org") = "scene.org"
 //
 // Examples using unique, known initialisms:
 //
@@ -144,10 +256,11 @@ func Link(path string) string {
 //	Obfuscate("TDT / TRSi") = "coop"
 //	Obfuscate("United Software Association + Fairlight PC Division") = "united-software-association*fairlight"
 func Obfuscate(s string) string {
-	x := fix.StripStart(s)
+	raw := strings.TrimSpace(s)
+	x := fix.StripEndsKnown(s, known)
 	x = strings.TrimSpace(x)
 	for uri, special := range maps.All(*specials) {
-		if strings.EqualFold(x, special) {
+		if strings.EqualFold(x, special) || strings.EqualFold(raw, special) {
 			return string(uri)
 		}
 	}
@@ -158,31 +271,92 @@ func Obfuscate(s string) string {
 			}
 		}
 	}
+	for _, y := range []string{raw, x} {
+		if base, word := qualified(y); word != "" {
+			if p := Obfuscate(base); p != "" && !strings.Contains(p, "*") {
+				return p + "-" + word
+			}
+		}
+	}
+	return string(obfuscate(x))
+}
+
+// qualified splits the trailing qualifier, such as "(NJ)" or "#2", from the name and
+// returns the name and the URL path word of the qualifier, see [name.Qualify].
+// If the name has no trailing qualifier then an empty word is returned.
+func qualified(s string) (string, string) {
+	i := strings.LastIndex(s, "#")
+	if strings.HasSuffix(s, ")") {
+		i = strings.LastIndex(s, "(")
+	}
+	if i < 1 {
+		return s, ""
+	}
+	word, ok := name.Qualify(s[i:])
+	if !ok {
+		return s, ""
+	}
+	return strings.TrimSpace(s[:i]), word
+}
+
+// obfuscate cleans the string and returns it as a URL path,
+// without checking for any known initialisms or special names.
+func obfuscate(s string) name.Path {
+	x := fix.Collapse(s)
 	x = fix.StripChars(x)
 	x = fix.TrimThe(x)
 	x = fix.TrimSP(x)
-	c := name.Obfuscate(x)
-	return string(c)
+	return undotted(name.Obfuscate(x))
+}
+
+// undotted returns the known URL path of a domain name that is listed without its dots,
+// such as "defacto2net" for "defacto2.net", otherwise the path is returned unchanged.
+func undotted(path name.Path) name.Path {
+	if paths[path] || !strings.Contains(string(path), ".") {
+		return path
+	}
+	// dotted acronyms, such as "m.y.t.h", are never domain names
+	if i := strings.LastIndex(string(path), "."); !name.TopLevelDomain(string(path)[i+1:]) {
+		return path
+	}
+	if p := name.Path(strings.ReplaceAll(string(path), ".", "")); paths[p] {
+		return p
+	}
+	return path
 }
 
 // Title formats the string to be used as a title or the basis for a LIKE SQL query.
 // Any known initialisms, acronyms or special names are deobfuscated.
+// The title is not escaped for use in a LIKE pattern, so use the
+// [github.com/Defacto2/releaser/sqlpattern] package to build the query.
+// Names of known releasers that join their words together are split using [Unjoin].
 //
 // Example:
 //
 //	Title("razor 1911") = "Razor 1911"
 //	Title("_.=[   RaZoR 1911   ]=._") = "Razor 1911"
+//	Title("-=xX Razor 1911 Xx=-") = "Razor 1911"
+//	Title("R A Z O R  1 9 1 1") = "Razor 1911"
+//	Title("S.W.A.T") = "SWaT"
+//	Title("razor1911") = "Razor 1911"
+//	Title("scene.org") = "Scene.org"
 //	Title("COOP") = "TDT / TRSi"
 //	Title("tdt / trsi") = "TDT / TRSi"
 //	Title("nappa") = "North American Pirate-Phreak Association"
 func Title(s string) string {
-	x := fix.StripStart(s)
+	raw := strings.TrimSpace(s)
+	x := fix.StripEndsKnown(s, known)
 	x = strings.TrimSpace(x)
 	for _, special := range *specials {
-		if strings.EqualFold(x, special) {
+		if strings.EqualFold(x, special) || strings.EqualFold(raw, special) {
 			return special
 		}
 	}
+	if base, word := qualified(raw); word != "" {
+		if t := Title(base); t != "" {
+			return t + " (" + name.StyledQualifier(word) + ")"
+		}
+	}
 	for uri, values := range maps.All(*initialisms) {
 		for value := range slices.Values(values) {
 			if strings.EqualFold(x, value) {
@@ -190,9 +364,15 @@ func Title(s string) string {
 			}
 		}
 	}
+	x = fix.Collapse(x)
 	x = fix.StripChars(x)
 	x = fix.TrimThe(x)
 	x = fix.TrimSP(x)
-	c := name.Obfuscate(x)
+	c := undotted(name.Obfuscate(x))
+	if !paths[c] {
+		if joined, found := Unjoin(x); found {
+			c = joined
+		}
+	}
 	return Humanize(string(c))
 }
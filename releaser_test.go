@@ -57,9 +57,9 @@ func ExampleIndex() {
 	fmt.Println(releaser.Index("united-software-association*fairlight"))
 	fmt.Println(releaser.Index("class*paradigm*razor-1911"))
 	fmt.Println(releaser.Index("coop"))
-	// Output: UNITED SOFTWARE ASSOCIATION, FAIRLIGHT
+	// Output: UNITED SOFTWARE ASSOCIATION + FAIRLIGHT PC DIVISION
 	// CLASS, PARADIGM, RAZOR 1911
-	// COOP
+	// TDT / TRSI
 }
 
 func BenchmarkCell(b *testing.B) {
@@ -151,13 +151,19 @@ func TestCell(t *testing.T) {
 		want string
 	}{
 		{"empty string", args{""}, ""},
-		{"single word", args{"defacto2.net"}, "defacto2net"},
+		{"single word", args{"defacto2.net"}, "defacto2.net"},
+		{"wrapped domain", args{"(defacto2.net)!"}, "defacto2.net"},
+		{"dotted acronym", args{"G.O.D. network"}, "G.O.D. Network"},
+		{"bare dotted acronym", args{"G.O.D."}, "G.O.D."},
+		{"bare dotted acronym 4", args{"M.Y.T.H."}, "M.Y.T.H."},
 		{"leading the", args{"the blah"}, "The Blah"},
 		{"common the", args{"in the blah"}, "In the Blah"},
 		{"no spaces", args{"TheBlah"}, "Theblah"},
 		{"elite fmt", args{"MiRROR now"}, "Mirror Now"},
 		{"roman numbers", args{"In the row now ii"}, "In the Row Now II"},
 		{"BBS", args{"MiRROR now bbS"}, "Mirror Now BBS"},
+		{"spaced out", args{"R A Z O R  1 9 1 1"}, "Razor 1911"},
+		{"dotted", args{"F.A.I.R.L.I.G.H.T"}, "Fairlight"},
 		{"slug", args{"this-is-a-slug-string"}, "This-is-a-Slug-String"},
 		{
 			"pair of groups",
@@ -182,6 +188,7 @@ func TestCell(t *testing.T) {
 		{"example 2", args{"  the x bbs  "}, "X BBS"},
 		{"example 3", args{"TDT / TRSi"}, "TDT TRSI"},
 		{"example 4", args{"TDT,TRSi"}, "TDT, TRSI"},
+		{"apostrophe", args{"The Devil's Realm BBS"}, "Devils Realm BBS"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -235,6 +242,22 @@ func TestClean(t *testing.T) {
 		{"example 3", args{"The X Ftp"}, "X FTP"},
 		{"example 4", args{"tdt / trsi"}, "Tdt Trsi"},
 		{"example 5", args{"tdt,trsi"}, "Tdt, TRSi"},
+		{"ornament", args{"-=xX Razor 1911 Xx=-"}, "Razor 1911"},
+		{"mirrored ornament", args{".oO Fairlight Oo."}, "Fairlight"},
+		{"attached ornament", args{"///dR///"}, "DR"},
+		{"known symbol", args{"Scorpion ¥"}, "Scorpion ¥"},
+		{"apostrophe", args{"The Devil's Realm BBS"}, "Devil's Realm BBS"},
+		{"restored apostrophe", args{"the devils realm bbs"}, "Devil's Realm BBS"},
+		{"typographic apostrophe", args{"Frank’s Palace"}, "Frank's Palace"},
+		{"domain", args{"SCENE.ORG"}, "Scene.org"},
+		{"dotted acronym", args{"x.y.z. crew"}, "X.Y.Z. Crew"},
+		{"bare dotted acronym", args{"G.O.D."}, "G.O.D."},
+		{"bare dotted acronym 4", args{"m.y.t.h."}, "M.Y.T.H."},
+		{"dotted special", args{"e.mail compilation"}, "e.mail compilation"},
+		{"region qualifier", args{"TDT (NJ)"}, "TDT (NJ)"},
+		{"platform qualifier", args{"Razor 1911 (Amiga)"}, "Razor 1911 (Amiga)"},
+		{"number qualifier", args{"the dream team #2"}, "The Dream Team (#2)"},
+		{"not a qualifier", args{"Razor 1911 (The Best)"}, "Razor 1911 the Best"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -280,6 +303,40 @@ func TestHumanize(t *testing.T) {
 		{"down-town-bbs*bizare-bbs", "Down Town BBS, Bizare BBS"},
 		{"united-software-association*fairlight", "United Software Association + Fairlight PC Division"},
 		{"coop", "TDT / TRSi"},
+		{"hamburger-heavan-bbs", "Hamburger Heaven BBS"},
+		{"thedreamteam", "The Dream Team"},
+		{"razor1911", "Razor 1911"},
+		{"darkstar", "Darkstar"},
+		{"devils-realm-bbs", "Devil's Realm BBS"},
+		{"marauders-hideout-bbs", "Marauder's Hideout BBS"},
+		{"rogues-gallery-bbs", "Rogues' Gallery BBS"},
+		{"lost-souls-domain-ii-bbs", "Lost Souls Domain II BBS"},
+		{"circuits-edge-bbs", "Circuits Edge BBS"},
+		{"the-gamers-edge", "The Gamers Edge"},
+		{"pirates-cove", "Pirates Cove"},
+		{"boners-domain-bbs", "Boner's Domain BBS"},
+		{"well-release-anything", "We'll Release Anything"},
+		{"image-nj", "iMAGE (NJ)"},
+		{"razor-1911-amiga", "Razor 1911 (Amiga)"},
+		{"the-dream-team-2", "The Dream Team (#2)"},
+		{"fairlight-1994", "Fairlight (1994)"},
+		{"majic-12", "Majic 12"},
+		{"unknown-group-nj", "Unknown Group NJ"},
+		{"unknown-group-12", "Unknown Group 12"},
+		{"team-17", "Team 17"},
+		{"catch-22", "Catch 22"},
+		{"pirates-usa", "Pirates USA"},
+		{"digital-2000", "Digital 2000"},
+		{"some-group-amiga", "Some Group Amiga"},
+		{"scene.org", "Scene.org"},
+		{"g.o.d", "G.O.D."},
+		{"m.y.t.h", "M.Y.T.H."},
+		{"red-dot-bbs", "Red Dot BBS"},
+		{"polka-dot-crew", "Polka Dot Crew"},
+		{"the-dot-org", "The Dot Org"},
+		{"dot-matrix-dot-com", "Dot Matrix Dot Com"},
+		{"dot-matrix.com", "Dot Matrix.com"},
+		{"x.y.z-crew", "X.Y.Z. Crew"},
 	}
 
 	for _, tc := range testCases {
@@ -349,6 +406,25 @@ func TestObfuscate(t *testing.T) {
 		{"readme example 2", "ACiD Productions", "acid-productions"},
 		{"readme example 3", "Razor 1911 Demo & Skillion", "razor-1911-demo-ampersand-skillion"},
 		{"readme example 4", "TDU-Jam!", "tdu_jam"},
+		{"spaced out", "R A Z O R 1 9 1 1", "razor-1911"},
+		{"apostrophe", "The Devil's Realm BBS", "devils-realm-bbs"},
+		{"qualifier", "iMAGE (NJ)", "image-nj"},
+		{"platform qualifier", "Razor 1911 (Amiga)", "razor-1911-amiga"},
+		{"number qualifier", "The Dream Team (#2)", "the-dream-team-2"},
+		{"initialism qualifier", "TDT (#2)", "the-dream-team-2"},
+		{"unknown qualifier", "Unknown Group (NJ)", "unknown-group-nj"},
+		{"initialism region qualifier", "TDT (NJ)", "the-dream-team-nj"},
+		{"initialism year qualifier", "TDT (1994)", "the-dream-team-1994"},
+		{"decorated qualifier", "-=TDT (#2)=-", "the-dream-team-2"},
+		{"not a qualifier", "Razor 1911 (The Best)", "razor-1911-the-best"},
+		{"domain", "Scene.org", "scene.org"},
+		{"the word dot", "Red Dot BBS", "red-dot-bbs"},
+		{"known domain", "Defacto2.net", "defacto2net"},
+		{"dotted acronym", "X.Y.Z. Crew", "x.y.z-crew"},
+		{"bare dotted acronym", "G.O.D.", "g.o.d"},
+		{"bare dotted acronym 4", "M.Y.T.H.", "m.y.t.h"},
+		{"dotted initialism", "A.C.E.", "art-creation-enterprise"},
+		{"dotted initialism bbs", "R.P.M BBS", "rpm-bbs"},
 		{
 			"readme example 5", "United Software Association + Fairlight PC Division",
 			"united-software-association*fairlight",
@@ -367,6 +443,22 @@ func TestObfuscate(t *testing.T) {
 	}
 }
 
+func TestQualifierRoundTrip(t *testing.T) {
+	t.Parallel()
+	// every kind of qualifier of a known releaser survives Obfuscate and Humanize
+	for _, s := range []string{
+		"The Dream Team (NJ)", "The Dream Team (Amiga)", "The Dream Team (#2)", "The Dream Team (1994)",
+		"Razor 1911 (UK)", "Razor 1911 (C64)", "Razor 1911 (#12)", "Razor 1911 (2001)",
+	} {
+		if got := releaser.Humanize(releaser.Obfuscate(s)); got != s {
+			t.Errorf("Humanize(Obfuscate(%q)) = %q", s, got)
+		}
+	}
+	if got := releaser.Humanize(releaser.Obfuscate("TDT (NJ)")); got != "The Dream Team (NJ)" {
+		t.Errorf("Humanize(Obfuscate(%q)) = %q", "TDT (NJ)", got)
+	}
+}
+
 func TestTitle(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -377,6 +469,22 @@ func TestTitle(t *testing.T) {
 		{"empty string", "", ""},
 		{"standard", "razor 1911", "Razor 1911"},
 		{"casing", " _.=[   RaZoR 1911   ]=._ ", "Razor 1911"},
+		{"ornament", "-=xX Razor 1911 Xx=-", "Razor 1911"},
+		{"filler", "xxxxx Fairlight xxxxx", "Fairlight"},
+		{"spaced out", "R A Z O R  1 9 1 1", "Razor 1911"},
+		{"dotted", "S.W.A.T", "SWaT"},
+		{"bare dotted acronym", "G.O.D.", "G.O.D."},
+		{"bare dotted acronym 4", "M.Y.T.H.", "M.Y.T.H."},
+		{"spaced out special", "T H E  D R E A M  T E A M", "The Dream Team"},
+		{"letters and digits", "razor1911", "Razor 1911"},
+		{"camel case", "Razor1911Demo", "Razor 1911 Demo"},
+		{"joined", "thedreamteam", "The Dream Team"},
+		{"qualifier", "Razor 1911 (Amiga)", "Razor 1911 (Amiga)"},
+		{"number qualifier", "the dream team #2", "The Dream Team (#2)"},
+		{"unknown qualifier", "unknown group (nj)", "Unknown Group (NJ)"},
+		{"domain", "scene.org", "Scene.org"},
+		{"known domain", "Defacto2.net", "Defacto2 website"},
+		{"framed initialism", "[ nappa ]", "North American Pirate-Phreak Association"},
 		{"special name", "coop", "TDT / TRSi"},
 		{"special name", "tdt / trsi", "TDT / TRSi"},
 		{"initialism", "nappa", "North American Pirate-Phreak Association"},
@@ -0,0 +1,119 @@
+package releaser
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/Defacto2/releaser/fix"
+	"github.com/Defacto2/releaser/name"
+)
+
+// paths are a cache of the known URL paths that is used by Unjoin.
+var paths = func() map[name.Path]bool { //nolint:gochecknoglobals
+	m := make(map[name.Path]bool, len(*specials)+len(*initialisms))
+	for path := range *specials {
+		m[path] = true
+	}
+	for path := range *initialisms {
+		m[name.Path(path)] = true
+	}
+	return m
+}()
+
+// vocabulary is a cache of the words used by the known URL paths that is used by Segment.
+var vocabulary = func() map[string]bool { //nolint:gochecknoglobals
+	m := make(map[string]bool)
+	for path := range paths {
+		for _, word := range strings.FieldsFunc(string(path), separator) {
+			m[word] = true
+		}
+	}
+	return m
+}()
+
+// separator returns true if the rune separates the words of a URL path.
+func separator(r rune) bool {
+	return r == '-' || r == '_' || r == '*'
+}
+
+// Segment splits the joined-up words of a lowercased name into the words
+// used by the known URL paths. Runs of digits are always treated as a word.
+// The segmentation with the fewest words is returned, or nil if the name
+// cannot be split using the known words.
+//
+// Example:
+//
+//	Segment("thedreamteam") = []string{"the", "dream", "team"}
+//	Segment("razor1911demo") = []string{"razor", "1911", "demo"}
+//	Segment("fairlight") = []string{"fairlight"}
+func Segment(s string) []string {
+	n := len(s)
+	if n == 0 || strings.IndexFunc(s, func(r rune) bool {
+		return (r < 'a' || r > 'z') && (r < '0' || r > '9')
+	}) >= 0 {
+		return nil
+	}
+	digit := func(i int) bool {
+		return i >= 0 && i < n && unicode.IsDigit(rune(s[i]))
+	}
+	// fewest holds the fewest words needed to segment s[:i], and start is the start of its last word
+	fewest := make([]int, n+1)
+	start := make([]int, n+1)
+	for i := 1; i <= n; i++ {
+		fewest[i] = -1
+		for j := range i {
+			if fewest[j] < 0 {
+				continue
+			}
+			word := s[j:i]
+			numeric := strings.IndexFunc(word, func(r rune) bool { return !unicode.IsDigit(r) }) < 0
+			if numeric && (digit(j-1) || digit(i)) {
+				continue
+			}
+			if !numeric && !vocabulary[word] {
+				continue
+			}
+			if count := fewest[j] + 1; fewest[i] < 0 || count < fewest[i] {
+				fewest[i], start[i] = count, j
+			}
+		}
+	}
+	if fewest[n] < 0 {
+		return nil
+	}
+	words := make([]string, fewest[n])
+	for i, w := n, len(words)-1; i > 0; i, w = start[i], w-1 {
+		words[w] = s[start[i]:i]
+	}
+	return words
+}
+
+// Unjoin returns the known URL path of a name that joins its words together
+// using CamelCase or without any spaces, or false if no known path is found.
+// The words are first split using [fix.SplitCase] and then by [Segment].
+//
+// Example:
+//
+//	Unjoin("Razor1911") = "razor-1911", true
+//	Unjoin("thedreamteam") = "the-dream-team", true
+//	Unjoin("ShitOnlyGerman") = "shitonlygerman", true
+//	Unjoin("DefactoTwo") = "", false
+func Unjoin(s string) (name.Path, bool) {
+	if path := name.Obfuscate(fix.SplitCase(s)); paths[path] {
+		return path, true
+	}
+	joined := strings.Map(func(r rune) rune {
+		if separator(r) || unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, string(name.Obfuscate(s)))
+	words := Segment(joined)
+	if len(words) == 0 {
+		return "", false
+	}
+	if path := name.Path(strings.Join(words, "-")); paths[path] {
+		return path, true
+	}
+	return "", false
+}
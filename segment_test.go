@@ -0,0 +1,72 @@
+package releaser_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Defacto2/releaser"
+	"github.com/Defacto2/releaser/name"
+	"github.com/nalgeon/be"
+)
+
+func ExampleSegment() {
+	fmt.Println(strings.Join(releaser.Segment("thedreamteam"), " "))
+	// Output: the dream team
+}
+
+func ExampleUnjoin() {
+	path, found := releaser.Unjoin("Razor1911")
+	fmt.Println(string(path), found)
+	// Output: razor-1911 true
+}
+
+func TestSegment(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		s    string
+		want []string
+	}{
+		{"empty", "", nil},
+		{"known word", "fairlight", []string{"fairlight"}},
+		{"joined", "thedreamteam", []string{"the", "dream", "team"}},
+		{"digits", "razor1911demo", []string{"razor", "1911", "demo"}},
+		{"digits only", "1911", []string{"1911"}},
+		{"unknown", "qqqzzz", nil},
+		{"uppercase", "TheDreamTeam", nil},
+		{"separator", "the-dream-team", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			be.Equal(t, releaser.Segment(tt.s), tt.want)
+		})
+	}
+}
+
+func TestUnjoin(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		s     string
+		want  name.Path
+		found bool
+	}{
+		{"empty", "", "", false},
+		{"letters and digits", "razor1911", "razor-1911", true},
+		{"camel case", "Razor1911Demo", "razor-1911-demo", true},
+		{"joined", "thedreamteam", "the-dream-team", true},
+		{"known joined path", "ShitOnlyGerman", "shitonlygerman", true},
+		{"suffix", "fairlightdox", "fairlight-dox", true},
+		{"unknown", "DefactoTwo", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, found := releaser.Unjoin(tt.s)
+			be.Equal(t, got, tt.want)
+			be.Equal(t, found, tt.found)
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package releaser
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// numberWidth is the width that runs of digits are padded to, so numbers sort naturally.
+const numberWidth = 10
+
+// SortKey returns the key used to sort the releaser name in a directory listing.
+// The key is a lowercased string of letters, digits and spaces, and ordering keys by
+// their bytes gives the same order as [golang.org/x/text/collate] using the
+// IgnoreCase, IgnoreDiacritics and Numeric options.
+//
+//   - A leading "The" is ignored, so "The Dream Team" sorts under D
+//   - Numbers are sorted naturally, so "12AM" sorts before "2000AD"
+//   - Diacritics and case are folded, so "Ëclipse" sorts with "Eclipse"
+//   - Collaborations are sorted by their first member, so "TDT / TRSi" sorts under TDT,
+//     but a slash or plus within a name is kept, so "OB/GYN" sorts as "ob gyn"
+//
+// The name is expected to be humanized, but URL paths are also accepted.
+//
+// Example:
+//
+//	SortKey("The Dream Team") = "dream team"
+//	SortKey("Razor 1911") = "razor 0000001911"
+//	SortKey("Ëclipse") = "eclipse"
+//	SortKey("Razor 1911 Demo, TRSi") = "razor 0000001911 demo"
+func SortKey(name string) string {
+	first := name
+	for _, sep := range memberSeparators {
+		first, _, _ = strings.Cut(first, sep)
+	}
+	words := strings.FieldsFunc(fold(first), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '.' && r != '\''
+	})
+	if len(words) > 1 && words[0] == "the" {
+		words = words[1:]
+	}
+	for i, word := range words {
+		word = strings.NewReplacer(".", "", "'", "").Replace(word)
+		words[i] = natural(word)
+	}
+	return strings.Join(strings.Fields(strings.Join(words, " ")), " ")
+}
+
+// Bucket returns the letter group of the releaser name used by a directory listing,
+// which is an uppercase letter from "A" to "Z", or "0-9" for names that begin with a number.
+// Names that begin with a letter of another script return "#",
+// and names without any letters or numbers return an empty string.
+// The group is the first character of the [SortKey].
+//
+// Example:
+//
+//	Bucket("The Dream Team") = "D"
+//	Bucket("2000AD") = "0-9"
+//	Bucket("Ëclipse") = "E"
+func Bucket(name string) string {
+	key := SortKey(name)
+	if key == "" {
+		return ""
+	}
+	switch r := key[0]; {
+	case r >= '0' && r <= '9':
+		return "0-9"
+	case r >= 'a' && r <= 'z':
+		return string(unicode.ToUpper(rune(r)))
+	}
+	return "#"
+}
+
+// fold returns the lowercased string with the diacritics removed.
+// Letters that do not decompose, such as "ø" or "ß", are replaced with their Latin spelling.
+func fold(s string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	x, _, err := transform.String(t, s)
+	if err != nil {
+		x = s
+	}
+	x = strings.NewReplacer(
+		"ß", "ss", "æ", "ae", "Æ", "ae", "œ", "oe", "Œ", "oe",
+		"ø", "o", "Ø", "o", "đ", "d", "Đ", "d", "ł", "l", "Ł", "l",
+	).Replace(x)
+	return strings.ToLower(x)
+}
+
+// natural returns the word with every run of digits padded with leading zeros
+// to the numberWidth, so that the numbers sort in their numeric order.
+func natural(word string) string {
+	var b strings.Builder
+	digits := ""
+	flush := func() {
+		if digits == "" {
+			return
+		}
+		digits = strings.TrimLeft(digits, "0")
+		if len(digits) < numberWidth {
+			b.WriteString(strings.Repeat("0", numberWidth-len(digits)))
+		}
+		b.WriteString(digits)
+		digits = ""
+	}
+	for _, r := range word {
+		if r >= '0' && r <= '9' {
+			digits += string(r)
+			continue
+		}
+		flush()
+		b.WriteRune(r)
+	}
+	flush()
+	return b.String()
+}
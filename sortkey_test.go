@@ -0,0 +1,107 @@
+package releaser_test
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Defacto2/releaser"
+	"github.com/nalgeon/be"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+func ExampleSortKey() {
+	names := []string{"Zeus", "The Dream Team", "2000AD", "12AM BBS", "Ëclipse", "Dead Ringers"}
+	slices.SortFunc(names, func(a, b string) int {
+		return strings.Compare(releaser.SortKey(a), releaser.SortKey(b))
+	})
+	for _, name := range names {
+		fmt.Println(releaser.Bucket(name), name)
+	}
+	// Output: 0-9 12AM BBS
+	// 0-9 2000AD
+	// D Dead Ringers
+	// D The Dream Team
+	// E Ëclipse
+	// Z Zeus
+}
+
+func TestSortKey(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"", ""},
+		{"The", "the"},
+		{"The Dream Team", "dream team"},
+		{"the-dream-team", "dream team"},
+		{"Razor 1911", "razor 0000001911"},
+		{"12AM BBS", "0000000012am bbs"},
+		{"Ëclipse", "eclipse"},
+		{"Ørjan & Søren", "orjan soren"},
+		{"Devil's Realm BBS", "devils realm bbs"},
+		{"G.O.D.", "god"},
+		{"Razor 1911 Demo, TRSi", "razor 0000001911 demo"},
+		{"Class + Paradigm", "class"},
+		{"TDT / TRSi", "tdt"},
+		{"razor-1911-demo*trsi", "razor 0000001911 demo"},
+		{"OB/GYN", "ob gyn"},
+		{"EXCEL/XL!", "excel xl"},
+		{"Fx/2 Graphics Group", "fx 0000000002 graphics group"},
+		{"C+C Crew, TRSi", "c c crew"},
+		{"Fx/2 Graphics Group / TRSi", "fx 0000000002 graphics group"},
+		{"-=[ ]=-", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			be.Equal(t, releaser.SortKey(tt.name), tt.want)
+		})
+	}
+}
+
+func TestSortKeyCollate(t *testing.T) {
+	t.Parallel()
+	// The names are listed without a leading "The" or any collaborations,
+	// as those are handled by SortKey before the comparison.
+	names := []string{
+		"Zeus", "Dream Team", "2000AD", "12AM BBS", "Ëclipse", "Eclipse Crew", "Razor 1911",
+		"Razor 2", "razor 1911 demo", "ACiD Productions", "Äpfel", "Abyss", "9 Fingers",
+		"Fairlight", "Fairlight 2", "Fairlight 10", "Ñu", "Nu Style",
+	}
+	c := collate.New(language.Und, collate.IgnoreCase, collate.IgnoreDiacritics, collate.Numeric)
+	want := slices.Clone(names)
+	c.SortStrings(want)
+	got := slices.Clone(names)
+	slices.SortStableFunc(got, func(a, b string) int {
+		return strings.Compare(releaser.SortKey(a), releaser.SortKey(b))
+	})
+	be.Equal(t, got, want)
+}
+
+func TestBucket(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"", ""},
+		{"-=[ ]=-", ""},
+		{"The Dream Team", "D"},
+		{"razor 1911", "R"},
+		{"2000AD", "0-9"},
+		{"Ëclipse", "E"},
+		{"Ångström", "A"},
+		{"Крыса", "#"},
+		{"TDT / TRSi", "T"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			be.Equal(t, releaser.Bucket(tt.name), tt.want)
+		})
+	}
+}
@@ -0,0 +1,231 @@
+// Package sqlpattern builds safe SQL LIKE and ILIKE patterns for the names of releasers.
+//
+// Names can contain the underscore and percent characters, which are wildcards in a
+// LIKE pattern, so they are escaped using a backslash and every clause
+// includes an ESCAPE '\' clause.
+// The patterns are passed to the database as query arguments, never as SQL text.
+//
+// The conditions are written for PostgreSQL and SQLite. MySQL and MariaDB are not supported,
+// as they treat the backslash of the ESCAPE '\' clause as an escape within the string literal.
+package sqlpattern
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Defacto2/releaser"
+	"github.com/Defacto2/releaser/initialism"
+	"github.com/Defacto2/releaser/name"
+)
+
+// EscapeChar is the escape character of the patterns.
+const EscapeChar = `\`
+
+// An Operator is the SQL pattern matching operator.
+type Operator string
+
+// The SQL pattern matching operators.
+const (
+	Like  Operator = "LIKE"  // Like is the case-sensitive pattern match.
+	ILike Operator = "ILIKE" // ILike is the case-insensitive pattern match of PostgreSQL.
+)
+
+// A Match is the way a pattern matches the column value.
+type Match uint8
+
+// The ways a pattern can match the column value.
+const (
+	Exact    Match = iota // Exact matches the whole value.
+	Prefix                // Prefix matches the values that begin with the name.
+	Contains              // Contains matches the values that contain the name.
+)
+
+// Escape returns the string with the escape character and the wildcards escaped.
+//
+// Example:
+//
+//	Escape("100% Pure") = `100\% Pure`
+//	Escape("tdu_jam") = `tdu\_jam`
+func Escape(s string) string {
+	r := strings.NewReplacer(EscapeChar, EscapeChar+EscapeChar, "%", EscapeChar+"%", "_", EscapeChar+"_")
+	return r.Replace(s)
+}
+
+// Pattern returns the escaped pattern of the string for the match.
+//
+// Example:
+//
+//	Pattern("Razor 1911", Exact) = "Razor 1911"
+//	Pattern("Razor 1911", Prefix) = "Razor 1911%"
+//	Pattern("Razor_1911", Contains) = `%Razor\_1911%`
+func Pattern(s string, m Match) string {
+	switch m {
+	case Prefix:
+		return Escape(s) + "%"
+	case Contains:
+		return "%" + Escape(s) + "%"
+	case Exact:
+	}
+	return Escape(s)
+}
+
+// Variants returns the names to search for the query, which begin with the trimmed query as it
+// was given, followed by the [releaser.Title] of the query. The variants include the name and
+// the humanized name of a known initialism, both with and without a leading "The", and every
+// alias from the initialism dictionary. A form with or without "The" is skipped when it is
+// the name of a different releaser, so "tdt" never matches "Dream Team".
+// An initialism used by more than one releaser lists the names of every releaser,
+// sorted by their URL paths.
+// Variants that only differ by case are removed, but the query is always kept as it was given,
+// and an empty query returns no variants.
+//
+// Example:
+//
+//	Variants("the dream team") = []string{"the dream team", "The Dream Team", "TDT"}
+//	Variants("tdt") = []string{"tdt", "The Dream Team", "TDT"}
+//	Variants("X BBS") = []string{"X BBS", "The X BBS"}
+//	Variants("100%") = []string{"100%", "100", "The 100"}
+func Variants(query string) []string {
+	literal := strings.TrimSpace(query)
+	if literal == "" {
+		return nil
+	}
+	variants := []string{}
+	add := func(s string) {
+		if s = strings.TrimSpace(s); s != "" {
+			variants = append(variants, s)
+		}
+	}
+	toggle := func(s string) {
+		if s = strings.TrimSpace(s); s == "" {
+			return
+		}
+		add(s)
+		const the = "The "
+		toggled := the + s
+		if len(s) > len(the) && strings.EqualFold(s[:len(the)], the) {
+			toggled = s[len(the):]
+		}
+		// a toggled form that is the name of another releaser, such as "Dream Team", is skipped
+		if p := releaser.Obfuscate(toggled); p == releaser.Obfuscate(s) || !known(p) {
+			add(toggled)
+		}
+	}
+	paths := matches(literal, releaser.Clean(literal))
+	if len(paths) < 2 {
+		title := releaser.Title(query)
+		toggle(title)
+		if paths = matches(title); len(paths) == 0 && title != "" {
+			paths = []initialism.Path{initialism.Path(releaser.Obfuscate(title))}
+		}
+	}
+	for _, path := range paths {
+		toggle(releaser.Humanize(string(path)))
+		for _, alias := range initialism.Initialism(path) {
+			add(alias)
+		}
+	}
+	return append([]string{literal}, dedupe(slices.DeleteFunc(variants, func(s string) bool {
+		return s == literal
+	}))...)
+}
+
+// known returns true if the URL path is a releaser with a special name or an initialism.
+func known(path string) bool {
+	_, special := (*name.Special())[name.Path(path)]
+	return special || initialism.IsInitialism(initialism.Path(path))
+}
+
+// matches returns the sorted URL paths of the initialisms that match any of the names.
+func matches(names ...string) []initialism.Path {
+	paths := []initialism.Path{}
+	for _, s := range names {
+		paths = append(paths, initialism.Match(s)...)
+	}
+	slices.Sort(paths)
+	return slices.Compact(paths)
+}
+
+// dedupe returns the strings without the later strings that only differ by case.
+func dedupe(s []string) []string {
+	keep := make([]string, 0, len(s))
+	for _, x := range s {
+		found := false
+		for _, k := range keep {
+			if strings.EqualFold(k, x) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			keep = append(keep, x)
+		}
+	}
+	return keep
+}
+
+// Where returns the SQL condition that matches the column using any of the patterns,
+// and the patterns as the query arguments of the "?" placeholders.
+// The patterns must already be escaped, see [Pattern].
+// The column is written as is, so it must not come from user input.
+// The condition is valid in PostgreSQL and SQLite, but not in MySQL or MariaDB.
+// If there are no patterns then a condition that is always false is returned.
+//
+// Example:
+//
+//	Where("name", ILike, "Razor 1911%", "The Razor 1911%") =
+//		`(name ILIKE ? ESCAPE '\' OR name ILIKE ? ESCAPE '\')`, []any{"Razor 1911%", "The Razor 1911%"}
+func Where(column string, op Operator, patterns ...string) (string, []any) {
+	if len(patterns) == 0 {
+		return "FALSE", nil
+	}
+	conditions := make([]string, 0, len(patterns))
+	args := make([]any, 0, len(patterns))
+	for _, p := range patterns {
+		conditions = append(conditions, column+" "+string(op)+" ? ESCAPE '"+EscapeChar+"'")
+		args = append(args, p)
+	}
+	return "(" + strings.Join(conditions, " OR ") + ")", args
+}
+
+// Search returns the SQL condition and the query arguments that match the column
+// using every variant of the query, see [Variants].
+//
+// Example:
+//
+//	Search("name", ILike, "X BBS", Prefix) =
+//		`(name ILIKE ? ESCAPE '\' OR name ILIKE ? ESCAPE '\')`, []any{"X BBS%", "The X BBS%"}
+func Search(column string, op Operator, query string, m Match) (string, []any) {
+	variants := Variants(query)
+	patterns := make([]string, 0, len(variants))
+	for _, v := range variants {
+		patterns = append(patterns, Pattern(v, m))
+	}
+	return Where(column, op, patterns...)
+}
+
+// Dollar returns the SQL condition with the "?" placeholders replaced by the
+// numbered PostgreSQL placeholders, starting from $n.
+// The "?" characters within quoted strings of the condition are kept.
+//
+// Example:
+//
+//	Dollar(`(name LIKE ? ESCAPE '\' OR name LIKE ? ESCAPE '\')`, 1) =
+//		`(name LIKE $1 ESCAPE '\' OR name LIKE $2 ESCAPE '\')`
+func Dollar(condition string, n int) string {
+	var b strings.Builder
+	quoted := false
+	for _, r := range condition {
+		switch {
+		case r == '\'':
+			quoted = !quoted
+		case r == '?' && !quoted:
+			b.WriteString("$" + strconv.Itoa(n))
+			n++
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,105 @@
+package sqlpattern_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Defacto2/releaser/sqlpattern"
+	"github.com/nalgeon/be"
+)
+
+func ExampleSearch() {
+	where, args := sqlpattern.Search("name", sqlpattern.ILike, "X BBS", sqlpattern.Prefix)
+	fmt.Println(where)
+	fmt.Println(args)
+	fmt.Println(sqlpattern.Dollar(where, 1))
+	// Output: (name ILIKE ? ESCAPE '\' OR name ILIKE ? ESCAPE '\')
+	// [X BBS% The X BBS%]
+	// (name ILIKE $1 ESCAPE '\' OR name ILIKE $2 ESCAPE '\')
+}
+
+func TestEscape(t *testing.T) {
+	t.Parallel()
+	be.Equal(t, sqlpattern.Escape(""), "")
+	be.Equal(t, sqlpattern.Escape("Razor 1911"), "Razor 1911")
+	be.Equal(t, sqlpattern.Escape("100% Pure"), `100\% Pure`)
+	be.Equal(t, sqlpattern.Escape("tdu_jam"), `tdu\_jam`)
+	be.Equal(t, sqlpattern.Escape(`a\b`), `a\\b`)
+}
+
+func TestPattern(t *testing.T) {
+	t.Parallel()
+	be.Equal(t, sqlpattern.Pattern("Razor_1911", sqlpattern.Exact), `Razor\_1911`)
+	be.Equal(t, sqlpattern.Pattern("Razor_1911", sqlpattern.Prefix), `Razor\_1911%`)
+	be.Equal(t, sqlpattern.Pattern("Razor_1911", sqlpattern.Contains), `%Razor\_1911%`)
+}
+
+func TestVariants(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"", nil},
+		{"  ", nil},
+		{"-=[ ]=-", []string{"-=[ ]=-"}},
+		{"the dream team", []string{"the dream team", "The Dream Team", "TDT"}},
+		{"tdt", []string{"tdt", "The Dream Team", "TDT"}},
+		{"dream team", []string{"dream team", "Dream Team", "DT"}},
+		{"X BBS", []string{"X BBS", "The X BBS"}},
+		{"The X BBS", []string{"The X BBS", "X BBS"}},
+		{"some random group", []string{"some random group", "Some Random Group", "The Some Random Group"}},
+		{" 100% ", []string{"100%", "100", "The 100"}},
+		{"%", []string{"%"}},
+		{"_", []string{"_"}},
+		{
+			"air", []string{
+				"air", "Addiction in Releasing", "The Addiction in Releasing", "AiR",
+				"Artists in Revolt", "The Artists in Revolt",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			t.Parallel()
+			be.Equal(t, sqlpattern.Variants(tt.query), tt.want)
+		})
+	}
+}
+
+func TestVariantsDeterministic(t *testing.T) {
+	t.Parallel()
+	// initialisms used by more than one releaser must list every releaser in the same order
+	want := sqlpattern.Variants("AIR")
+	for range 50 {
+		be.Equal(t, sqlpattern.Variants("AIR"), want)
+	}
+}
+
+func TestSearchWildcards(t *testing.T) {
+	t.Parallel()
+	_, args := sqlpattern.Search("name", sqlpattern.Like, "100%", sqlpattern.Exact)
+	be.Equal(t, args[0], any(`100\%`))
+	_, args = sqlpattern.Search("name", sqlpattern.Like, "tdu_jam", sqlpattern.Prefix)
+	be.Equal(t, args[0], any(`tdu\_jam%`))
+	where, args := sqlpattern.Search("name", sqlpattern.Like, "%", sqlpattern.Contains)
+	be.Equal(t, where, `(name LIKE ? ESCAPE '\')`)
+	be.Equal(t, args, []any{`%\%%`})
+}
+
+func TestWhere(t *testing.T) {
+	t.Parallel()
+	where, args := sqlpattern.Where("name", sqlpattern.Like)
+	be.Equal(t, where, "FALSE")
+	be.Equal(t, len(args), 0)
+	where, args = sqlpattern.Where("name", sqlpattern.Like, `%a\_b%`)
+	be.Equal(t, where, `(name LIKE ? ESCAPE '\')`)
+	be.Equal(t, args, []any{`%a\_b%`})
+	where, _ = sqlpattern.Search("name", sqlpattern.Like, "", sqlpattern.Exact)
+	be.Equal(t, where, "FALSE")
+}
+
+func TestDollar(t *testing.T) {
+	t.Parallel()
+	be.Equal(t, sqlpattern.Dollar("a = ? AND b = '?' AND c = ?", 3), "a = $3 AND b = '?' AND c = $4")
+}